@@ -5,19 +5,43 @@ import (
 	"errors"
 	"net/http"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
+	_ "time/tzdata"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 
+	apiKeyHandler "github.com/avraam311/calendar-service/internal/api/handlers/apikey"
+	caldavHandler "github.com/avraam311/calendar-service/internal/api/handlers/caldav"
+	calendarHandler "github.com/avraam311/calendar-service/internal/api/handlers/calendar"
 	eventHandler "github.com/avraam311/calendar-service/internal/api/handlers/event"
+	feedHandler "github.com/avraam311/calendar-service/internal/api/handlers/feed"
+	settingsHandler "github.com/avraam311/calendar-service/internal/api/handlers/settings"
 	"github.com/avraam311/calendar-service/internal/api/server"
 	"github.com/avraam311/calendar-service/internal/config"
+	"github.com/avraam311/calendar-service/internal/middlewares"
 	"github.com/avraam311/calendar-service/internal/pkg/logger"
+	"github.com/avraam311/calendar-service/internal/pkg/notifier"
+	"github.com/avraam311/calendar-service/internal/pkg/period"
 	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	apiKeyRepo "github.com/avraam311/calendar-service/internal/repository/apikey"
+	archiveRepo "github.com/avraam311/calendar-service/internal/repository/archive"
+	caldavRepo "github.com/avraam311/calendar-service/internal/repository/caldav"
+	calendarRepo "github.com/avraam311/calendar-service/internal/repository/calendar"
 	eventRepo "github.com/avraam311/calendar-service/internal/repository/event"
+	feedRepo "github.com/avraam311/calendar-service/internal/repository/feed"
+	reminderRepo "github.com/avraam311/calendar-service/internal/repository/reminder"
+	settingsRepo "github.com/avraam311/calendar-service/internal/repository/settings"
+	apiKeyService "github.com/avraam311/calendar-service/internal/service/apikey"
+	caldavService "github.com/avraam311/calendar-service/internal/service/caldav"
+	calendarService "github.com/avraam311/calendar-service/internal/service/calendar"
 	eventService "github.com/avraam311/calendar-service/internal/service/event"
+	feedService "github.com/avraam311/calendar-service/internal/service/feed"
+	settingsService "github.com/avraam311/calendar-service/internal/service/settings"
+	archiverWorker "github.com/avraam311/calendar-service/internal/worker/archiver"
+	reminderWorker "github.com/avraam311/calendar-service/internal/worker/reminder"
 )
 
 func main() {
@@ -29,6 +53,11 @@ func main() {
 	mdLog := logger.SetupLogger(cfg.Logger.Env, cfg.Logger.MdLogFilePath)
 	val := validator.New()
 
+	weekStart, err := period.ParseWeekday(cfg.Calendar.FirstWeekday)
+	if err != nil {
+		log.Fatal("error parsing first weekday", zap.Error(err))
+	}
+
 	dbpool, err := pgxpool.New(ctx, cfg.DatabaseURL())
 	if err != nil {
 		log.Fatal("error creating connection pool", zap.Error(err))
@@ -37,10 +66,55 @@ func main() {
 	eventR := eventRepo.New(dbpool)
 	eventS := eventService.New(eventR)
 	eventPostH := eventHandler.NewPostHandler(log, val, eventS)
-	eventGetH := eventHandler.NewGetHandler(log, val, eventS)
-	r := server.NewRouter(eventPostH, eventGetH, mdLog)
+	eventGetH := eventHandler.NewGetHandler(log, val, eventS, weekStart)
+	attendeeH := eventHandler.NewAttendeeHandler(log, val, eventS)
+	tagH := eventHandler.NewTagHandler(log, val, eventS)
+	calendarR := calendarRepo.New(dbpool)
+	calendarS := calendarService.New(calendarR)
+	calendarH := calendarHandler.NewHandler(log, val, calendarS)
+	settingsR := settingsRepo.New(dbpool)
+	settingsS := settingsService.New(settingsR)
+	settingsH := settingsHandler.NewHandler(log, val, settingsS)
+	feedR := feedRepo.New(dbpool)
+	feedS := feedService.New(feedR)
+	feedH := feedHandler.NewHandler(log, feedS, eventS)
+	caldavR := caldavRepo.New(dbpool)
+	caldavS := caldavService.New(caldavR)
+	caldavH := caldavHandler.NewHandler(log, val, caldavS, eventS)
+	apiKeyR := apiKeyRepo.New(dbpool)
+	apiKeyS := apiKeyService.New(apiKeyR)
+	apiKeyH := apiKeyHandler.NewHandler(log, val, apiKeyS)
+	keyfunc, err := middlewares.NewKeyfunc(cfg.Auth.Algorithm, cfg.Auth.Secret, cfg.Auth.PublicKeyFile, cfg.Auth.JWKSFile)
+	if err != nil {
+		log.Fatal("error loading auth keys", zap.Error(err))
+	}
+	auth := middlewares.Auth(log, keyfunc, cfg.Auth.Algorithm, cfg.Auth.Issuer, cfg.Auth.Audience)
+	apiKeyAuth := middlewares.APIKeyAuth(log, apiKeyS)
+	basicAuth := middlewares.BasicAuth(log, apiKeyS)
+	r := server.NewRouter(eventPostH, eventGetH, attendeeH, tagH, feedH, calendarH, caldavH, apiKeyH, settingsH, auth,
+		apiKeyAuth, basicAuth, mdLog)
 	s := server.NewServer(cfg.Server.HTTPPort, r)
 
+	reminderR := reminderRepo.New(dbpool)
+	reminderW := reminderWorker.New(log, reminderR, cfg.Reminder.Interval, newNotifiers(cfg, log))
+
+	archiveR := archiveRepo.New(dbpool)
+	archiverW := archiverWorker.New(log, archiveR, cfg.Archive.Interval, cfg.Archive.MaxAge)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		log.Info("starting reminder worker", zap.Duration("interval", cfg.Reminder.Interval))
+		reminderW.Run(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		log.Info("starting archiver", zap.Duration("interval", cfg.Archive.Interval),
+			zap.Duration("max_age", cfg.Archive.MaxAge))
+		archiverW.Run(ctx)
+	}()
+
 	go func() {
 		log.Info("starting HTTP server", zap.String("port", cfg.Server.HTTPPort))
 		if err = s.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -59,6 +133,9 @@ func main() {
 		log.Error("could not shutdown HTTP server", zap.Error(err))
 	}
 
+	log.Info("waiting for background workers...")
+	wg.Wait()
+
 	if errors.Is(shutdownCtx.Err(), context.DeadlineExceeded) {
 		log.Fatal("timeout exceeded, forcing shutdown")
 	}
@@ -66,3 +143,23 @@ func main() {
 	log.Info("closing database pool...")
 	dbpool.Close()
 }
+
+func newNotifiers(cfg *config.Config, log *zap.Logger) []reminderWorker.Notifier {
+	var notifiers []reminderWorker.Notifier
+	for _, name := range cfg.Reminder.Notifiers {
+		switch name {
+		case "log":
+			notifiers = append(notifiers, notifier.NewLog(log))
+		case "webhook":
+			notifiers = append(notifiers, notifier.NewWebhook(cfg.Reminder.Webhook.URL, cfg.Reminder.Webhook.Timeout))
+		case "smtp":
+			smtpCfg := cfg.Reminder.SMTP
+			notifiers = append(notifiers, notifier.NewSMTP(smtpCfg.Host, smtpCfg.Port, smtpCfg.Username,
+				smtpCfg.Password, smtpCfg.From, smtpCfg.RecipientFormat))
+		default:
+			log.Fatal("unknown notifier", zap.String("notifier", name))
+		}
+	}
+
+	return notifiers
+}
@@ -0,0 +1,192 @@
+package apikey
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/middlewares"
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	apiKeyR "github.com/avraam311/calendar-service/internal/repository/apikey"
+)
+
+type Handler struct {
+	logger        *zap.Logger
+	validator     *validator.GoValidator
+	apiKeyService apiKeyService
+}
+
+func NewHandler(l *zap.Logger, v *validator.GoValidator, s apiKeyService) *Handler {
+	return &Handler{
+		logger:        l,
+		validator:     v,
+		apiKeyService: s,
+	}
+}
+
+func (h *Handler) CreateKey(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	var keyCreate *models.APIKeyCreate
+	err := json.NewDecoder(r.Body).Decode(&keyCreate)
+	if err != nil || keyCreate == nil {
+		h.logger.Warn("failed to decode JSON", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	keyCreate.UserID = userID
+
+	err = h.validator.Validate(keyCreate)
+	if err != nil {
+		h.logger.Warn("validation error", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "validation error")
+		return
+	}
+
+	issued, err := h.apiKeyService.CreateKey(r.Context(), keyCreate)
+	if err != nil {
+		h.logger.Error("failed to create api key", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	h.logger.Info("api key created", zap.Int("user_id", userID), zap.Uint("key_id", issued.ID))
+
+	h.writeResult(w, http.StatusCreated, issued)
+}
+
+func (h *Handler) GetKeys(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	keys, err := h.apiKeyService.GetKeys(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to get api keys", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	h.writeResult(w, http.StatusOK, keys)
+}
+
+func (h *Handler) RotateKey(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	ID, ok := h.keyID(w, r)
+	if !ok {
+		return
+	}
+
+	issued, err := h.apiKeyService.RotateKey(r.Context(), userID, ID)
+	if err != nil {
+		h.respondKeyError(w, err, "failed to rotate api key")
+		return
+	}
+
+	h.logger.Info("api key rotated", zap.Int("user_id", userID), zap.Uint("key_id", ID))
+
+	h.writeResult(w, http.StatusOK, issued)
+}
+
+func (h *Handler) RevokeKey(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	ID, ok := h.keyID(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.apiKeyService.RevokeKey(r.Context(), userID, ID)
+	if err != nil {
+		h.respondKeyError(w, err, "failed to revoke api key")
+		return
+	}
+
+	h.logger.Info("api key revoked", zap.Int("user_id", userID), zap.Uint("key_id", ID))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// userID returns the caller. Keys are managed by users only, so a request
+// authenticated with an API key cannot issue or rotate keys.
+func (h *Handler) userID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	if _, ok := middlewares.CallerAPIKey(r.Context()); ok {
+		h.logger.Warn("api key management with an api key")
+		h.handleError(w, http.StatusForbidden, "api keys cannot manage api keys")
+		return 0, false
+	}
+
+	userID, ok := middlewares.UserID(r.Context())
+	if !ok {
+		h.logger.Warn("unauthenticated request")
+		h.handleError(w, http.StatusUnauthorized, "unauthorized")
+		return 0, false
+	}
+
+	return userID, true
+}
+
+func (h *Handler) keyID(w http.ResponseWriter, r *http.Request) (uint, bool) {
+	ID, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 0)
+	if err != nil || ID == 0 {
+		h.logger.Warn("invalid api key id", zap.String("id", chi.URLParam(r, "id")))
+		h.handleError(w, http.StatusBadRequest, "invalid api key id")
+		return 0, false
+	}
+
+	return uint(ID), true
+}
+
+func (h *Handler) respondKeyError(w http.ResponseWriter, err error, msg string) {
+	if errors.Is(err, apiKeyR.ErrKeyNotFound) {
+		h.logger.Warn("api key not found")
+		h.handleError(w, http.StatusNotFound, "api key not found")
+		return
+	}
+
+	h.logger.Error(msg, zap.Error(err))
+	h.handleError(w, http.StatusInternalServerError, "internal error")
+}
+
+func (h *Handler) writeResult(w http.ResponseWriter, code int, result any) {
+	response := map[string]any{
+		"result": result,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		h.logger.Error("failed to encode response", zap.Error(err))
+		http.Error(w, "response encoding error", http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) handleError(w http.ResponseWriter, code int, msg string) {
+	errorResponse := map[string]string{
+		"error": msg,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(errorResponse)
+	if err != nil {
+		h.logger.Error("failed to encode error response", zap.Error(err))
+		http.Error(w, "error response encoding error", http.StatusInternalServerError)
+	}
+}
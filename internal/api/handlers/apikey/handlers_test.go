@@ -0,0 +1,130 @@
+//go:build unit
+// +build unit
+
+package apikey
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/middlewares"
+	"github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	apiKeyR "github.com/avraam311/calendar-service/internal/repository/apikey"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocks.MockapiKeyService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocks.NewMockapiKeyService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	return ctrl, mockService, NewHandler(logger, validator.New(), mockService)
+}
+
+// newRequest builds a request authenticated as user 1.
+func newRequest(method, target, body string) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	return req.WithContext(middlewares.ContextWithUserID(req.Context(), 1))
+}
+
+func withKeyID(req *http.Request, ID uint) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", fmt.Sprint(ID))
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestHandlerCreateKey(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		CreateKey(gomock.Any(), &models.APIKeyCreate{UserID: 1, Name: "batch", Scopes: []string{"read"}}).
+		Return(&models.APIKeyIssued{APIKey: models.APIKey{ID: 3, Name: "batch"}, Key: "cal_secret"}, nil)
+
+	w := httptest.NewRecorder()
+	h.CreateKey(w, newRequest(http.MethodPost, "/api_keys", `{"name": "batch", "scopes": ["read"]}`))
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+
+	var response map[string]models.APIKeyIssued
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response["result"].Key != "cal_secret" || response["result"].ID != 3 {
+		t.Fatalf("unexpected response %+v", response)
+	}
+}
+
+func TestHandlerCreateKeyInvalidScope(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.CreateKey(w, newRequest(http.MethodPost, "/api_keys", `{"name": "batch", "scopes": ["admin"]}`))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerCreateKeyWithAPIKey(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	authenticator := mocks.NewMockapiKeyAuthenticator(ctrl)
+	authenticator.EXPECT().
+		Authenticate(gomock.Any(), "cal_write").
+		Return(&models.APIKey{ID: 2, UserID: 1, Scopes: []string{"write"}}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api_keys", strings.NewReader(`{"name": "x", "scopes": ["write"]}`))
+	req.Header.Set(middlewares.APIKeyHeader, "cal_write")
+	w := httptest.NewRecorder()
+	middlewares.APIKeyAuth(zap.NewNop(), authenticator)(http.HandlerFunc(h.CreateKey)).ServeHTTP(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
+
+func TestHandlerRotateKey(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		RotateKey(gomock.Any(), 1, uint(3)).
+		Return(&models.APIKeyIssued{APIKey: models.APIKey{ID: 3}, Key: "cal_new"}, nil)
+
+	w := httptest.NewRecorder()
+	h.RotateKey(w, withKeyID(newRequest(http.MethodPost, "/api_keys/3/rotate", ""), 3))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandlerRevokeKeyNotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		RevokeKey(gomock.Any(), 1, uint(3)).
+		Return(fmt.Errorf("service/RevokeKey - %w", apiKeyR.ErrKeyNotFound))
+
+	w := httptest.NewRecorder()
+	h.RevokeKey(w, withKeyID(newRequest(http.MethodDelete, "/api_keys/3", ""), 3))
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
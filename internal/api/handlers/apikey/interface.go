@@ -0,0 +1,15 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+//go:generate mockgen -source=interface.go -destination=../../../mocks/mock_apikey_handlers.go -package=mocks
+type apiKeyService interface {
+	CreateKey(ctx context.Context, keyCreate *models.APIKeyCreate) (*models.APIKeyIssued, error)
+	GetKeys(ctx context.Context, userID int) ([]*models.APIKey, error)
+	RotateKey(ctx context.Context, userID int, ID uint) (*models.APIKeyIssued, error)
+	RevokeKey(ctx context.Context, userID int, ID uint) error
+}
@@ -0,0 +1,521 @@
+package caldav
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/middlewares"
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/ical"
+	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	caldavR "github.com/avraam311/calendar-service/internal/repository/caldav"
+)
+
+const (
+	BasePath = "/caldav"
+
+	maxResourceSize = 1 << 20
+	contentType     = "text/calendar; charset=utf-8; component=VEVENT"
+	syncTokenPrefix = "http://calendar-service/ns/sync/"
+	allowedMethods  = "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, REPORT"
+)
+
+type targetKind int
+
+const (
+	targetRoot targetKind = iota
+	targetPrincipal
+	targetCalendar
+	targetEvent
+)
+
+// target is the resource a CalDAV request addresses:
+//
+//	/caldav/                              root
+//	/caldav/users/{id}/                   principal and calendar home
+//	/caldav/users/{id}/calendar/          the user's calendar collection
+//	/caldav/users/{id}/calendar/{uid}.ics an event
+type target struct {
+	kind   targetKind
+	userID int
+	uid    string
+}
+
+type Handler struct {
+	logger        *zap.Logger
+	validator     *validator.GoValidator
+	caldavService caldavService
+	eventService  eventWriter
+}
+
+func NewHandler(l *zap.Logger, v *validator.GoValidator, c caldavService, e eventWriter) *Handler {
+	return &Handler{
+		logger:        l,
+		validator:     v,
+		caldavService: c,
+		eventService:  e,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	t, ok := parseTarget(r.URL.EscapedPath())
+	if !ok {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	userID, ok := middlewares.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if t.kind == targetRoot {
+		t.userID = userID
+	} else if t.userID != userID {
+		h.logger.Warn("caldav access to another user's calendar", zap.Int("user_id", userID),
+			zap.Int("owner_id", t.userID))
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodOptions:
+		w.Header().Set("DAV", "1, 3, calendar-access")
+		w.Header().Set("Allow", allowedMethods)
+		w.WriteHeader(http.StatusOK)
+	case r.Method == "PROPFIND":
+		h.propfind(w, r, t)
+	case r.Method == "REPORT" && t.kind == targetCalendar:
+		h.report(w, r, t)
+	case (r.Method == http.MethodGet || r.Method == http.MethodHead) && t.kind == targetEvent:
+		h.getEvent(w, r, t)
+	case r.Method == http.MethodPut && t.kind == targetEvent:
+		h.putEvent(w, r, t)
+	case r.Method == http.MethodDelete && t.kind == targetEvent:
+		h.deleteEvent(w, r, t)
+	default:
+		w.Header().Set("Allow", allowedMethods)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// WellKnown redirects service discovery (RFC 6764) to the CalDAV root.
+func (h *Handler) WellKnown(w http.ResponseWriter, r *http.Request) {
+	http.Redirect(w, r, BasePath+"/", http.StatusMovedPermanently)
+}
+
+func (h *Handler) getEvent(w http.ResponseWriter, r *http.Request, t *target) {
+	event, ok := h.lookup(w, r, t)
+	if !ok {
+		return
+	}
+	if event == nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	data, err := encodeEvent(event)
+	if err != nil {
+		h.logger.Error("failed to encode event", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("ETag", etag(event))
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		if _, err = io.WriteString(w, data); err != nil {
+			h.logger.Error("failed to write event", zap.Error(err))
+		}
+	}
+}
+
+// putEvent creates or replaces an event. The calendar object must carry the
+// UID the resource is named after; overridden occurrences it contains are
+// stored as standalone events.
+func (h *Handler) putEvent(w http.ResponseWriter, r *http.Request, t *target) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxResourceSize))
+	if err != nil {
+		http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	items, err := ical.Decode(bytes.NewReader(body))
+	if err != nil {
+		h.logger.Warn("failed to decode calendar", zap.Error(err))
+		http.Error(w, "invalid calendar data", http.StatusBadRequest)
+		return
+	}
+
+	var master *ical.Item
+	var overrides []*ical.Item
+	for _, item := range items {
+		switch {
+		case item.UID == t.uid:
+			master = item
+		case strings.HasPrefix(item.UID, t.uid+"_"):
+			overrides = append(overrides, item)
+		}
+	}
+	if master == nil {
+		http.Error(w, "calendar object UID must match the resource name", http.StatusBadRequest)
+		return
+	}
+	if master.Err != nil {
+		http.Error(w, master.Err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	existing, ok := h.lookup(w, r, t)
+	if !ok {
+		return
+	}
+	if !preconditionsMet(r, existing) {
+		http.Error(w, "precondition failed", http.StatusPreconditionFailed)
+		return
+	}
+
+	stored, err := h.overrides(r.Context(), t)
+	if err != nil {
+		h.respondSaveError(w, err)
+		return
+	}
+
+	// The series and its overridden occurrences are replaced together;
+	// overrides the client no longer sends are deleted.
+	err = h.eventService.InTx(r.Context(), func(ctx context.Context) error {
+		if err := h.save(ctx, t.userID, master.Event, existing); err != nil {
+			return err
+		}
+
+		for _, item := range overrides {
+			current := stored[item.UID]
+			delete(stored, item.UID)
+
+			if item.Err != nil {
+				h.logger.Warn("skipping overridden occurrence", zap.String("uid", item.UID), zap.Error(item.Err))
+				continue
+			}
+			if err := h.save(ctx, t.userID, item.Event, current); err != nil {
+				return err
+			}
+		}
+
+		for _, event := range stored {
+			_, err := h.eventService.DeleteEvent(ctx, &models.EventDelete{ID: event.ID, UserID: t.userID})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		h.respondSaveError(w, err)
+		return
+	}
+
+	saved, err := h.caldavService.GetEvent(r.Context(), t.userID, t.uid)
+	if err != nil {
+		h.logger.Error("failed to load saved event", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info("caldav event saved", zap.Int("user_id", t.userID), zap.String("uid", t.uid))
+
+	w.Header().Set("ETag", etag(saved))
+	if existing == nil {
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// overrides returns the stored overridden occurrences of the addressed series
+// by UID.
+func (h *Handler) overrides(ctx context.Context, t *target) (map[string]*models.Event, error) {
+	events, err := h.caldavService.GetOverrides(ctx, t.userID, t.uid)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := make(map[string]*models.Event, len(events))
+	for _, event := range events {
+		stored[event.UID] = event
+	}
+
+	return stored, nil
+}
+
+func (h *Handler) save(ctx context.Context, userID int, event *models.EventCreate, existing *models.Event) error {
+	event.UserID = userID
+	if err := h.validator.Validate(event); err != nil {
+		return &validationError{err}
+	}
+
+	// CalDAV clients have no way to show conflict warnings, so they are
+	// dropped.
+	if existing == nil {
+		_, _, err := h.eventService.CreateEvent(ctx, event)
+		return err
+	}
+
+	// iCalendar has no place for metadata, so the stored one is kept.
+	_, _, err := h.eventService.UpdateEvent(ctx, &models.EventUpdate{
+		Event: models.Event{
+			ID:          existing.ID,
+			UserID:      userID,
+			Title:       event.Title,
+			Description: event.Description,
+			Location:    event.Location,
+			URL:         event.URL,
+			Metadata:    existing.Metadata,
+			Date:        event.Date,
+			EndDate:     event.EndDate,
+			AllDay:      event.AllDay,
+			TimeZone:    event.TimeZone,
+			Reminders:   event.Reminders,
+			RRule:       event.RRule,
+			ExDates:     event.ExDates,
+		},
+	})
+	return err
+}
+
+type validationError struct {
+	err error
+}
+
+func (e *validationError) Error() string {
+	return e.err.Error()
+}
+
+func (h *Handler) respondSaveError(w http.ResponseWriter, err error) {
+	var vErr *validationError
+	if errors.As(err, &vErr) {
+		h.logger.Warn("validation error", zap.Error(err))
+		http.Error(w, "validation error", http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Error("failed to save caldav event", zap.Error(err))
+	http.Error(w, "internal error", http.StatusInternalServerError)
+}
+
+func (h *Handler) deleteEvent(w http.ResponseWriter, r *http.Request, t *target) {
+	event, ok := h.lookup(w, r, t)
+	if !ok {
+		return
+	}
+	if event == nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	if !preconditionsMet(r, event) {
+		http.Error(w, "precondition failed", http.StatusPreconditionFailed)
+		return
+	}
+
+	overrides, err := h.caldavService.GetOverrides(r.Context(), t.userID, t.uid)
+	if err != nil {
+		h.logger.Error("failed to get overridden occurrences", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// The overridden occurrences go with the series.
+	err = h.eventService.InTx(r.Context(), func(ctx context.Context) error {
+		for _, e := range append(overrides, event) {
+			if _, err := h.eventService.DeleteEvent(ctx, &models.EventDelete{ID: e.ID, UserID: t.userID}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		h.logger.Error("failed to delete caldav event", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info("caldav event deleted", zap.Int("user_id", t.userID), zap.String("uid", t.uid))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// lookup loads the addressed event; a missing event is reported as nil.
+func (h *Handler) lookup(w http.ResponseWriter, r *http.Request, t *target) (*models.Event, bool) {
+	event, err := h.caldavService.GetEvent(r.Context(), t.userID, t.uid)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, true
+		}
+
+		h.logger.Error("failed to get caldav event", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return event, true
+}
+
+func isNotFound(err error) bool {
+	return errors.Is(err, caldavR.ErrEventNotFound)
+}
+
+// preconditionsMet evaluates If-Match and If-None-Match against the current
+// state of the resource.
+func preconditionsMet(r *http.Request, existing *models.Event) bool {
+	if im := r.Header.Get("If-Match"); im != "" {
+		return existing != nil && matchesETag(im, etag(existing))
+	}
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		return existing == nil || !matchesETag(inm, etag(existing))
+	}
+
+	return true
+}
+
+func matchesETag(header, current string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || tag == current {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseTarget(escapedPath string) (*target, bool) {
+	rest, ok := strings.CutPrefix(escapedPath, BasePath)
+	if !ok {
+		return nil, false
+	}
+
+	var segments []string
+	for _, s := range strings.Split(rest, "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+
+	if len(segments) == 0 {
+		return &target{kind: targetRoot}, true
+	}
+	if segments[0] != "users" || len(segments) < 2 || len(segments) > 4 {
+		return nil, false
+	}
+
+	userID, err := strconv.Atoi(segments[1])
+	if err != nil || userID <= 0 {
+		return nil, false
+	}
+
+	t := &target{kind: targetPrincipal, userID: userID}
+	if len(segments) == 2 {
+		return t, true
+	}
+	if segments[2] != "calendar" {
+		return nil, false
+	}
+
+	t.kind = targetCalendar
+	if len(segments) == 3 {
+		return t, true
+	}
+
+	name, ok := strings.CutSuffix(segments[3], ".ics")
+	if !ok {
+		return nil, false
+	}
+	t.uid, err = url.PathUnescape(name)
+	if err != nil || t.uid == "" {
+		return nil, false
+	}
+
+	t.kind = targetEvent
+	return t, true
+}
+
+func principalPath(userID int) string {
+	return fmt.Sprintf("%s/users/%d/", BasePath, userID)
+}
+
+func calendarPath(userID int) string {
+	return principalPath(userID) + "calendar/"
+}
+
+func eventPath(userID int, uid string) string {
+	return calendarPath(userID) + url.PathEscape(uid) + ".ics"
+}
+
+func etag(e *models.Event) string {
+	return fmt.Sprintf(`"%d"`, e.Version)
+}
+
+func syncToken(token int64) string {
+	return syncTokenPrefix + strconv.FormatInt(token, 10)
+}
+
+func parseSyncToken(s string) (int64, error) {
+	value, ok := strings.CutPrefix(s, syncTokenPrefix)
+	if !ok {
+		return 0, fmt.Errorf("invalid sync token %q", s)
+	}
+
+	return strconv.ParseInt(value, 10, 64)
+}
+
+func encodeEvent(e *models.Event) (string, error) {
+	var b strings.Builder
+	if err := ical.Encode(&b, []*models.Event{e}, time.Now()); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// eventProps returns the properties of an event resource. calendar-data is
+// only encoded when it was asked for.
+func eventProps(e *models.Event, withData bool) (props, error) {
+	p := props{
+		propResourceType:   "",
+		propGetETag:        escape(etag(e)),
+		propGetContentType: contentType,
+	}
+
+	if withData {
+		data, err := encodeEvent(e)
+		if err != nil {
+			return nil, err
+		}
+		p[propCalendarData] = escape(data)
+	}
+
+	return p, nil
+}
+
+func hasProp(requested []xml.Name, name xml.Name) bool {
+	for _, n := range requested {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,335 @@
+//go:build unit
+// +build unit
+
+package caldav
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/middlewares"
+	"github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	caldavR "github.com/avraam311/calendar-service/internal/repository/caldav"
+)
+
+const putBody = "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\nUID:abc@example.com\r\nDTSTART:20260105T090000Z\r\nDTEND:20260105T100000Z\r\n" +
+	"SUMMARY:Standup\r\nEND:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocks.MockcaldavService, *mocks.MockeventWriter, http.Handler) {
+	ctrl := gomock.NewController(t)
+	mockCaldav := mocks.NewMockcaldavService(ctrl)
+	mockEvents := mocks.NewMockeventWriter(ctrl)
+	logger, _ := zap.NewDevelopment()
+	h := NewHandler(logger, validator.New(), mockCaldav, mockEvents)
+
+	chi.RegisterMethod("PROPFIND")
+	chi.RegisterMethod("REPORT")
+	r := chi.NewRouter()
+	r.Handle(BasePath+"/*", h)
+
+	return ctrl, mockCaldav, mockEvents, r
+}
+
+// expectTx runs the function passed to InTx as if in a transaction.
+func expectTx(m *mocks.MockeventWriter) *gomock.Call {
+	return m.EXPECT().
+		InTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+// serve dispatches the request authenticated as user 1.
+func serve(h http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req.WithContext(middlewares.ContextWithUserID(req.Context(), 1)))
+	return w
+}
+
+func TestHandlerPropfindCalendar(t *testing.T) {
+	ctrl, mockCaldav, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	date := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	mockCaldav.EXPECT().GetSyncToken(gomock.Any(), 1).Return(int64(42), nil)
+	mockCaldav.EXPECT().GetEvents(gomock.Any(), 1).Return([]*models.Event{
+		{ID: 5, UserID: 1, Title: "Standup", Date: date, EndDate: date, Version: 40},
+	}, nil)
+
+	body := `<?xml version="1.0"?><D:propfind xmlns:D="DAV:" xmlns:CS="http://calendarserver.org/ns/">` +
+		`<D:prop><D:resourcetype/><D:getetag/><CS:getctag/><D:quota-used-bytes/></D:prop></D:propfind>`
+	req := httptest.NewRequest("PROPFIND", "/caldav/users/1/calendar/", strings.NewReader(body))
+	req.Header.Set("Depth", "1")
+
+	w := serve(h, req)
+
+	if w.Code != http.StatusMultiStatus {
+		t.Fatalf("expected status %d, got %d", http.StatusMultiStatus, w.Code)
+	}
+	for _, want := range []string{
+		"<D:href>/caldav/users/1/calendar/</D:href>",
+		"<D:resourcetype><D:collection/><C:calendar/></D:resourcetype>",
+		"<CS:getctag>http://calendar-service/ns/sync/42</CS:getctag>",
+		"<D:href>/caldav/users/1/calendar/event-5@calendar-service.ics</D:href>",
+		"<D:getetag>&#34;40&#34;</D:getetag>",
+		"<D:quota-used-bytes></D:quota-used-bytes>",
+		"HTTP/1.1 404 Not Found",
+	} {
+		if !strings.Contains(w.Body.String(), want) {
+			t.Fatalf("response misses %q:\n%s", want, w.Body.String())
+		}
+	}
+}
+
+func TestHandlerPutNewEvent(t *testing.T) {
+	ctrl, mockCaldav, mockEvents, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockCaldav.EXPECT().GetEvent(gomock.Any(), 1, "abc@example.com").Return(nil, caldavR.ErrEventNotFound),
+		mockCaldav.EXPECT().GetOverrides(gomock.Any(), 1, "abc@example.com").Return(nil, nil),
+		expectTx(mockEvents),
+		mockEvents.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, e *models.EventCreate) (uint, []*models.Warning, error) {
+				if e.UserID != 1 || e.UID != "abc@example.com" || e.Title != "Standup" {
+					t.Fatalf("unexpected event %+v", e)
+				}
+				return 7, nil, nil
+			}),
+		mockCaldav.EXPECT().GetEvent(gomock.Any(), 1, "abc@example.com").
+			Return(&models.Event{ID: 7, UserID: 1, UID: "abc@example.com", Version: 43}, nil),
+	)
+
+	req := httptest.NewRequest(http.MethodPut, "/caldav/users/1/calendar/abc@example.com.ics", strings.NewReader(putBody))
+	req.Header.Set("If-None-Match", "*")
+
+	w := serve(h, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	if w.Header().Get("ETag") != `"43"` {
+		t.Fatalf("unexpected etag %q", w.Header().Get("ETag"))
+	}
+}
+
+func TestHandlerPutReconcilesOverrides(t *testing.T) {
+	ctrl, mockCaldav, mockEvents, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	body := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n" +
+		"BEGIN:VEVENT\r\nUID:abc@example.com\r\nDTSTART:20260105T090000Z\r\nDTEND:20260105T100000Z\r\n" +
+		"RRULE:FREQ=WEEKLY\r\nSUMMARY:Standup\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:abc@example.com\r\nRECURRENCE-ID:20260112T090000Z\r\n" +
+		"DTSTART:20260112T100000Z\r\nDTEND:20260112T110000Z\r\nSUMMARY:Late standup\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	gomock.InOrder(
+		mockCaldav.EXPECT().GetEvent(gomock.Any(), 1, "abc@example.com").
+			Return(&models.Event{ID: 7, UserID: 1, UID: "abc@example.com", Version: 43}, nil),
+		mockCaldav.EXPECT().GetOverrides(gomock.Any(), 1, "abc@example.com").Return([]*models.Event{
+			{ID: 8, UserID: 1, UID: "abc@example.com_20260112T090000Z", Version: 44},
+			{ID: 9, UserID: 1, UID: "abc@example.com_20260119T090000Z", Version: 45},
+		}, nil),
+		expectTx(mockEvents),
+		mockEvents.EXPECT().UpdateEvent(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, e *models.EventUpdate) (uint, []*models.Warning, error) {
+				if e.ID != 7 || e.RRule != "FREQ=WEEKLY" {
+					t.Fatalf("unexpected series %+v", e.Event)
+				}
+				return 7, nil, nil
+			}),
+		mockEvents.EXPECT().UpdateEvent(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, e *models.EventUpdate) (uint, []*models.Warning, error) {
+				if e.ID != 8 || e.Title != "Late standup" {
+					t.Fatalf("unexpected occurrence %+v", e.Event)
+				}
+				return 8, nil, nil
+			}),
+		mockEvents.EXPECT().DeleteEvent(gomock.Any(), &models.EventDelete{ID: 9, UserID: 1}).Return(uint(9), nil),
+		mockCaldav.EXPECT().GetEvent(gomock.Any(), 1, "abc@example.com").
+			Return(&models.Event{ID: 7, UserID: 1, UID: "abc@example.com", Version: 47}, nil),
+	)
+
+	req := httptest.NewRequest(http.MethodPut, "/caldav/users/1/calendar/abc@example.com.ics", strings.NewReader(body))
+	req.Header.Set("If-Match", `"43"`)
+
+	w := serve(h, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusNoContent, w.Code, w.Body.String())
+	}
+}
+
+func TestHandlerPutStaleETag(t *testing.T) {
+	ctrl, mockCaldav, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockCaldav.EXPECT().GetEvent(gomock.Any(), 1, "abc@example.com").
+		Return(&models.Event{ID: 7, UserID: 1, UID: "abc@example.com", Version: 43}, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/caldav/users/1/calendar/abc@example.com.ics", strings.NewReader(putBody))
+	req.Header.Set("If-Match", `"41"`)
+
+	w := serve(h, req)
+
+	if w.Code != http.StatusPreconditionFailed {
+		t.Fatalf("expected status %d, got %d", http.StatusPreconditionFailed, w.Code)
+	}
+}
+
+func TestHandlerPutUIDMismatch(t *testing.T) {
+	ctrl, _, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodPut, "/caldav/users/1/calendar/other.ics", strings.NewReader(putBody))
+
+	w := serve(h, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerDeleteEvent(t *testing.T) {
+	ctrl, mockCaldav, mockEvents, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockCaldav.EXPECT().GetEvent(gomock.Any(), 1, "event-5@calendar-service").
+		Return(&models.Event{ID: 5, UserID: 1, Version: 12}, nil)
+	mockCaldav.EXPECT().GetOverrides(gomock.Any(), 1, "event-5@calendar-service").Return(nil, nil)
+	expectTx(mockEvents)
+	mockEvents.EXPECT().DeleteEvent(gomock.Any(), &models.EventDelete{ID: 5, UserID: 1}).Return(uint(5), nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/caldav/users/1/calendar/event-5@calendar-service.ics", nil)
+	req.Header.Set("If-Match", `"12"`)
+
+	w := serve(h, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+}
+
+func TestHandlerDeleteEventWithOverrides(t *testing.T) {
+	ctrl, mockCaldav, mockEvents, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockCaldav.EXPECT().GetEvent(gomock.Any(), 1, "abc@example.com").
+		Return(&models.Event{ID: 7, UserID: 1, UID: "abc@example.com", Version: 43}, nil)
+	mockCaldav.EXPECT().GetOverrides(gomock.Any(), 1, "abc@example.com").
+		Return([]*models.Event{{ID: 8, UserID: 1, UID: "abc@example.com_20260112T090000Z", Version: 44}}, nil)
+	gomock.InOrder(
+		expectTx(mockEvents),
+		mockEvents.EXPECT().DeleteEvent(gomock.Any(), &models.EventDelete{ID: 8, UserID: 1}).Return(uint(8), nil),
+		mockEvents.EXPECT().DeleteEvent(gomock.Any(), &models.EventDelete{ID: 7, UserID: 1}).Return(uint(7), nil),
+	)
+
+	req := httptest.NewRequest(http.MethodDelete, "/caldav/users/1/calendar/abc@example.com.ics", nil)
+
+	w := serve(h, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+}
+
+func TestHandlerSyncCollection(t *testing.T) {
+	ctrl, mockCaldav, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	date := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	mockCaldav.EXPECT().GetChanges(gomock.Any(), 1, int64(40)).Return([]*models.EventChange{
+		{EventID: 5, Version: 41},
+		{EventID: 6, UID: "gone@example.com", Version: 42, Deleted: true},
+	}, int64(42), nil)
+	mockCaldav.EXPECT().GetEvents(gomock.Any(), 1).Return([]*models.Event{
+		{ID: 5, UserID: 1, Title: "Standup", Date: date, EndDate: date, Version: 41},
+	}, nil)
+
+	body := `<?xml version="1.0"?><D:sync-collection xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">` +
+		`<D:sync-token>http://calendar-service/ns/sync/40</D:sync-token><D:sync-level>1</D:sync-level>` +
+		`<D:prop><D:getetag/><C:calendar-data/></D:prop></D:sync-collection>`
+	req := httptest.NewRequest("REPORT", "/caldav/users/1/calendar/", strings.NewReader(body))
+
+	w := serve(h, req)
+
+	if w.Code != http.StatusMultiStatus {
+		t.Fatalf("expected status %d, got %d", http.StatusMultiStatus, w.Code)
+	}
+	for _, want := range []string{
+		"<D:sync-token>http://calendar-service/ns/sync/42</D:sync-token>",
+		"<D:href>/caldav/users/1/calendar/event-5@calendar-service.ics</D:href>",
+		"BEGIN:VCALENDAR",
+		"<D:href>/caldav/users/1/calendar/gone@example.com.ics</D:href><D:status>HTTP/1.1 404 Not Found</D:status>",
+	} {
+		if !strings.Contains(w.Body.String(), want) {
+			t.Fatalf("response misses %q:\n%s", want, w.Body.String())
+		}
+	}
+}
+
+func TestHandlerSyncCollectionInvalidToken(t *testing.T) {
+	ctrl, _, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	body := `<?xml version="1.0"?><D:sync-collection xmlns:D="DAV:">` +
+		`<D:sync-token>bogus</D:sync-token><D:prop><D:getetag/></D:prop></D:sync-collection>`
+	req := httptest.NewRequest("REPORT", "/caldav/users/1/calendar/", strings.NewReader(body))
+
+	w := serve(h, req)
+
+	if w.Code != http.StatusForbidden || !strings.Contains(w.Body.String(), "valid-sync-token") {
+		t.Fatalf("expected valid-sync-token error, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestHandlerCalendarMultiget(t *testing.T) {
+	ctrl, mockCaldav, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	date := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	mockCaldav.EXPECT().GetEvent(gomock.Any(), 1, "event-5@calendar-service").
+		Return(&models.Event{ID: 5, UserID: 1, Title: "Standup", Date: date, EndDate: date, Version: 41}, nil)
+	mockCaldav.EXPECT().GetEvent(gomock.Any(), 1, "missing").Return(nil, caldavR.ErrEventNotFound)
+
+	body := `<?xml version="1.0"?><C:calendar-multiget xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">` +
+		`<D:prop><D:getetag/><C:calendar-data/></D:prop>` +
+		`<D:href>/caldav/users/1/calendar/event-5@calendar-service.ics</D:href>` +
+		`<D:href>/caldav/users/1/calendar/missing.ics</D:href></C:calendar-multiget>`
+	req := httptest.NewRequest("REPORT", "/caldav/users/1/calendar/", strings.NewReader(body))
+
+	w := serve(h, req)
+
+	if w.Code != http.StatusMultiStatus {
+		t.Fatalf("expected status %d, got %d", http.StatusMultiStatus, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), "SUMMARY:Standup") ||
+		!strings.Contains(w.Body.String(), "missing.ics</D:href><D:status>HTTP/1.1 404 Not Found") {
+		t.Fatalf("unexpected response:\n%s", w.Body.String())
+	}
+}
+
+func TestHandlerOtherUsersCalendarForbidden(t *testing.T) {
+	ctrl, _, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodGet, "/caldav/users/2/calendar/event-5@calendar-service.ics", nil)
+	w := serve(h, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
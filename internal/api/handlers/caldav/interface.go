@@ -0,0 +1,25 @@
+package caldav
+
+import (
+	"context"
+	"time"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+//go:generate mockgen -source=interface.go -destination=../../../mocks/mock_caldav_handlers.go -package=mocks
+type caldavService interface {
+	GetEvents(ctx context.Context, userID int) ([]*models.Event, error)
+	GetEvent(ctx context.Context, userID int, UID string) (*models.Event, error)
+	GetOverrides(ctx context.Context, userID int, UID string) ([]*models.Event, error)
+	QueryEvents(ctx context.Context, userID int, from, to time.Time) ([]*models.Event, error)
+	GetSyncToken(ctx context.Context, userID int) (int64, error)
+	GetChanges(ctx context.Context, userID int, since int64) ([]*models.EventChange, int64, error)
+}
+
+type eventWriter interface {
+	CreateEvent(ctx context.Context, event *models.EventCreate) (uint, []*models.Warning, error)
+	UpdateEvent(ctx context.Context, event *models.EventUpdate) (uint, []*models.Warning, error)
+	DeleteEvent(ctx context.Context, eventDelete *models.EventDelete) (uint, error)
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,140 @@
+package caldav
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/pkg/ical"
+)
+
+func (h *Handler) propfind(w http.ResponseWriter, r *http.Request, t *target) {
+	requested, err := parsePropfind(r.Body)
+	if err != nil {
+		h.logger.Warn("failed to parse PROPFIND", zap.Error(err))
+		http.Error(w, "invalid PROPFIND body", http.StatusBadRequest)
+		return
+	}
+	depth := r.Header.Get("Depth")
+
+	ms := &multistatus{}
+	switch t.kind {
+	case targetRoot:
+		ms.Responses = append(ms.Responses, newResponse(BasePath+"/", requested, props{
+			propResourceType: "<D:collection/>",
+			propCurrentUser:  href(principalPath(t.userID)),
+		}))
+	case targetPrincipal:
+		ms.Responses = append(ms.Responses, newResponse(principalPath(t.userID), requested, principalProps(t.userID)))
+		if depth != "0" {
+			calendar, err := h.calendarProps(r, t.userID)
+			if err != nil {
+				h.respondError(w, err)
+				return
+			}
+			ms.Responses = append(ms.Responses, newResponse(calendarPath(t.userID), requested, calendar))
+		}
+	case targetCalendar:
+		calendar, err := h.calendarProps(r, t.userID)
+		if err != nil {
+			h.respondError(w, err)
+			return
+		}
+		ms.Responses = append(ms.Responses, newResponse(calendarPath(t.userID), requested, calendar))
+
+		if depth != "0" {
+			events, err := h.caldavService.GetEvents(r.Context(), t.userID)
+			if err != nil {
+				h.respondError(w, err)
+				return
+			}
+
+			for _, e := range events {
+				p, err := eventProps(e, hasProp(requested, propCalendarData))
+				if err != nil {
+					h.respondError(w, err)
+					return
+				}
+				ms.Responses = append(ms.Responses, newResponse(eventPath(t.userID, ical.UID(e)), requested, p))
+			}
+		}
+	case targetEvent:
+		event, ok := h.lookup(w, r, t)
+		if !ok {
+			return
+		}
+		if event == nil {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+
+		p, err := eventProps(event, hasProp(requested, propCalendarData))
+		if err != nil {
+			h.respondError(w, err)
+			return
+		}
+		ms.Responses = append(ms.Responses, newResponse(eventPath(t.userID, ical.UID(event)), requested, p))
+	}
+
+	if err = writeMultistatus(w, ms); err != nil {
+		h.logger.Error("failed to write multistatus", zap.Error(err))
+	}
+}
+
+// parsePropfind returns the requested property names; nil stands for allprop,
+// which is also what an empty body means.
+func parsePropfind(body io.Reader) ([]xml.Name, error) {
+	var req propfindRequest
+	err := xml.NewDecoder(body).Decode(&req)
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if req.AllProp != nil || req.Prop == nil {
+		return nil, nil
+	}
+
+	return req.Prop.list(), nil
+}
+
+func principalProps(userID int) props {
+	return props{
+		propResourceType:    "<D:collection/><D:principal/>",
+		propDisplayName:     escape(fmt.Sprintf("User %d", userID)),
+		propCurrentUser:     href(principalPath(userID)),
+		propPrincipalURL:    href(principalPath(userID)),
+		propCalendarHomeSet: href(principalPath(userID)),
+	}
+}
+
+func (h *Handler) calendarProps(r *http.Request, userID int) (props, error) {
+	token, err := h.caldavService.GetSyncToken(r.Context(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return props{
+		propResourceType:       "<D:collection/><C:calendar/>",
+		propDisplayName:        "Calendar",
+		propOwner:              href(principalPath(userID)),
+		propCurrentUser:        href(principalPath(userID)),
+		propSupportedComponent: `<C:comp name="VEVENT"/>`,
+		propSupportedReportSet: "<D:supported-report><D:report><C:calendar-query/></D:report></D:supported-report>" +
+			"<D:supported-report><D:report><C:calendar-multiget/></D:report></D:supported-report>" +
+			"<D:supported-report><D:report><D:sync-collection/></D:report></D:supported-report>",
+		propSyncToken: escape(syncToken(token)),
+		propGetCTag:   escape(syncToken(token)),
+	}, nil
+}
+
+func (h *Handler) respondError(w http.ResponseWriter, err error) {
+	h.logger.Error("caldav request failed", zap.Error(err))
+	http.Error(w, "internal error", http.StatusInternalServerError)
+}
@@ -0,0 +1,201 @@
+package caldav
+
+import (
+	"encoding/xml"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/ical"
+)
+
+const timeRangeLayout = "20060102T150405Z"
+
+func (h *Handler) report(w http.ResponseWriter, r *http.Request, t *target) {
+	var req reportRequest
+	if err := xml.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("failed to parse REPORT", zap.Error(err))
+		http.Error(w, "invalid REPORT body", http.StatusBadRequest)
+		return
+	}
+
+	var requested []xml.Name
+	if req.Prop != nil {
+		requested = req.Prop.list()
+	}
+
+	var (
+		ms  *multistatus
+		err error
+	)
+	switch req.XMLName {
+	case xml.Name{Space: nsCalDAV, Local: "calendar-query"}:
+		ms, err = h.calendarQuery(w, r, t, &req, requested)
+	case xml.Name{Space: nsCalDAV, Local: "calendar-multiget"}:
+		ms, err = h.calendarMultiget(r, t, &req, requested)
+	case xml.Name{Space: nsDAV, Local: "sync-collection"}:
+		ms, err = h.syncCollection(w, r, t, &req, requested)
+	default:
+		http.Error(w, "unsupported report", http.StatusForbidden)
+		return
+	}
+	if err != nil {
+		h.respondError(w, err)
+		return
+	}
+	if ms == nil {
+		return
+	}
+
+	if err = writeMultistatus(w, ms); err != nil {
+		h.logger.Error("failed to write multistatus", zap.Error(err))
+	}
+}
+
+func (h *Handler) calendarQuery(w http.ResponseWriter, r *http.Request, t *target, req *reportRequest,
+	requested []xml.Name) (*multistatus, error) {
+	var tr *timeRange
+	if req.Filter != nil {
+		tr = req.Filter.CompFilter.timeRange()
+	}
+
+	var (
+		events []*models.Event
+		err    error
+	)
+	if tr == nil {
+		events, err = h.caldavService.GetEvents(r.Context(), t.userID)
+	} else {
+		from, to, ok := parseTimeRange(tr)
+		if !ok {
+			http.Error(w, "invalid time-range", http.StatusBadRequest)
+			return nil, nil
+		}
+		events, err = h.caldavService.QueryEvents(r.Context(), t.userID, from, to)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsMultistatus(t.userID, events, requested)
+}
+
+func (h *Handler) calendarMultiget(r *http.Request, t *target, req *reportRequest,
+	requested []xml.Name) (*multistatus, error) {
+	ms := &multistatus{}
+	for _, path := range req.Hrefs {
+		ht, ok := parseTarget(path)
+		if !ok || ht.kind != targetEvent || ht.userID != t.userID {
+			ms.Responses = append(ms.Responses, response{Href: path, Status: statusLine(http.StatusNotFound)})
+			continue
+		}
+
+		event, err := h.caldavService.GetEvent(r.Context(), t.userID, ht.uid)
+		if err != nil {
+			if isNotFound(err) {
+				ms.Responses = append(ms.Responses, response{Href: path, Status: statusLine(http.StatusNotFound)})
+				continue
+			}
+			return nil, err
+		}
+
+		p, err := eventProps(event, hasProp(requested, propCalendarData))
+		if err != nil {
+			return nil, err
+		}
+		ms.Responses = append(ms.Responses, newResponse(path, requested, p))
+	}
+
+	return ms, nil
+}
+
+// syncCollection implements RFC 6578: without a token every event is
+// returned, otherwise only events changed since the token, with removed events
+// reported as 404.
+func (h *Handler) syncCollection(w http.ResponseWriter, r *http.Request, t *target, req *reportRequest,
+	requested []xml.Name) (*multistatus, error) {
+	var since int64
+	if req.SyncToken != "" {
+		var err error
+		since, err = parseSyncToken(req.SyncToken)
+		if err != nil {
+			w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(xml.Header + `<D:error xmlns:D="DAV:"><D:valid-sync-token/></D:error>`))
+			return nil, nil
+		}
+	}
+
+	changes, token, err := h.caldavService.GetChanges(r.Context(), t.userID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := h.caldavService.GetEvents(r.Context(), t.userID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]*models.Event, len(events))
+	for _, e := range events {
+		byID[e.ID] = e
+	}
+
+	ms := &multistatus{SyncToken: syncToken(token)}
+	for _, c := range changes {
+		e, ok := byID[c.EventID]
+		if c.Deleted || !ok {
+			if since == 0 {
+				continue
+			}
+			uid := ical.UID(&models.Event{ID: c.EventID, UID: c.UID})
+			ms.Responses = append(ms.Responses, response{
+				Href:   eventPath(t.userID, uid),
+				Status: statusLine(http.StatusNotFound),
+			})
+			continue
+		}
+
+		p, err := eventProps(e, hasProp(requested, propCalendarData))
+		if err != nil {
+			return nil, err
+		}
+		ms.Responses = append(ms.Responses, newResponse(eventPath(t.userID, ical.UID(e)), requested, p))
+	}
+
+	return ms, nil
+}
+
+func eventsMultistatus(userID int, events []*models.Event, requested []xml.Name) (*multistatus, error) {
+	ms := &multistatus{}
+	for _, e := range events {
+		p, err := eventProps(e, hasProp(requested, propCalendarData))
+		if err != nil {
+			return nil, err
+		}
+		ms.Responses = append(ms.Responses, newResponse(eventPath(userID, ical.UID(e)), requested, p))
+	}
+
+	return ms, nil
+}
+
+// parseTimeRange reads a CalDAV time-range; a missing bound is open.
+func parseTimeRange(tr *timeRange) (time.Time, time.Time, bool) {
+	from := time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	var err error
+	if tr.Start != "" {
+		if from, err = time.Parse(timeRangeLayout, tr.Start); err != nil {
+			return from, to, false
+		}
+	}
+	if tr.End != "" {
+		if to, err = time.Parse(timeRangeLayout, tr.End); err != nil {
+			return from, to, false
+		}
+	}
+
+	return from, to, to.After(from)
+}
@@ -0,0 +1,194 @@
+package caldav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+)
+
+const (
+	nsDAV    = "DAV:"
+	nsCalDAV = "urn:ietf:params:xml:ns:caldav"
+	nsCS     = "http://calendarserver.org/ns/"
+)
+
+var prefixes = map[string]string{
+	nsDAV:    "D",
+	nsCalDAV: "C",
+	nsCS:     "CS",
+}
+
+var (
+	propResourceType       = xml.Name{Space: nsDAV, Local: "resourcetype"}
+	propDisplayName        = xml.Name{Space: nsDAV, Local: "displayname"}
+	propGetETag            = xml.Name{Space: nsDAV, Local: "getetag"}
+	propGetContentType     = xml.Name{Space: nsDAV, Local: "getcontenttype"}
+	propCurrentUser        = xml.Name{Space: nsDAV, Local: "current-user-principal"}
+	propPrincipalURL       = xml.Name{Space: nsDAV, Local: "principal-URL"}
+	propOwner              = xml.Name{Space: nsDAV, Local: "owner"}
+	propSyncToken          = xml.Name{Space: nsDAV, Local: "sync-token"}
+	propSupportedReportSet = xml.Name{Space: nsDAV, Local: "supported-report-set"}
+	propCalendarHomeSet    = xml.Name{Space: nsCalDAV, Local: "calendar-home-set"}
+	propCalendarData       = xml.Name{Space: nsCalDAV, Local: "calendar-data"}
+	propSupportedComponent = xml.Name{Space: nsCalDAV, Local: "supported-calendar-component-set"}
+	propGetCTag            = xml.Name{Space: nsCS, Local: "getctag"}
+)
+
+// props maps property names to their already encoded XML content.
+type props map[xml.Name]string
+
+type multistatus struct {
+	XMLName   xml.Name   `xml:"D:multistatus"`
+	D         string     `xml:"xmlns:D,attr"`
+	C         string     `xml:"xmlns:C,attr"`
+	CS        string     `xml:"xmlns:CS,attr"`
+	Responses []response `xml:"D:response"`
+	SyncToken string     `xml:"D:sync-token,omitempty"`
+}
+
+type response struct {
+	Href      string     `xml:"D:href"`
+	Propstats []propstat `xml:"D:propstat,omitempty"`
+	Status    string     `xml:"D:status,omitempty"`
+}
+
+type propstat struct {
+	Prop   propValues `xml:"D:prop"`
+	Status string     `xml:"D:status"`
+}
+
+type propValues struct {
+	Values []propValue
+}
+
+type propValue struct {
+	XMLName xml.Name
+	Inner   string `xml:",innerxml"`
+}
+
+type propNames struct {
+	Names []struct {
+		XMLName xml.Name
+	} `xml:",any"`
+}
+
+func (p propNames) list() []xml.Name {
+	names := make([]xml.Name, 0, len(p.Names))
+	for _, n := range p.Names {
+		names = append(names, n.XMLName)
+	}
+
+	return names
+}
+
+type propfindRequest struct {
+	XMLName xml.Name   `xml:"DAV: propfind"`
+	AllProp *struct{}  `xml:"DAV: allprop"`
+	Prop    *propNames `xml:"DAV: prop"`
+}
+
+type reportRequest struct {
+	XMLName   xml.Name
+	Prop      *propNames `xml:"DAV: prop"`
+	Hrefs     []string   `xml:"DAV: href"`
+	SyncToken string     `xml:"DAV: sync-token"`
+	Filter    *struct {
+		CompFilter compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+	} `xml:"urn:ietf:params:xml:ns:caldav filter"`
+}
+
+type compFilter struct {
+	Name        string       `xml:"name,attr"`
+	TimeRange   *timeRange   `xml:"urn:ietf:params:xml:ns:caldav time-range"`
+	CompFilters []compFilter `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+}
+
+type timeRange struct {
+	Start string `xml:"start,attr"`
+	End   string `xml:"end,attr"`
+}
+
+// timeRange returns the time-range of the VEVENT comp-filter, if any.
+func (f compFilter) timeRange() *timeRange {
+	if f.Name == "VEVENT" && f.TimeRange != nil {
+		return f.TimeRange
+	}
+	for _, sub := range f.CompFilters {
+		if tr := sub.timeRange(); tr != nil {
+			return tr
+		}
+	}
+
+	return nil
+}
+
+// newResponse builds the propstats for the requested properties; nil means
+// every available property.
+func newResponse(href string, requested []xml.Name, available props) response {
+	found := propstat{Status: statusLine(http.StatusOK)}
+	missing := propstat{Status: statusLine(http.StatusNotFound)}
+
+	if requested == nil {
+		for name := range available {
+			requested = append(requested, name)
+		}
+	}
+
+	for _, name := range requested {
+		value, ok := available[name]
+		if ok {
+			found.Prop.Values = append(found.Prop.Values, propValue{XMLName: elementName(name), Inner: value})
+		} else {
+			missing.Prop.Values = append(missing.Prop.Values, propValue{XMLName: elementName(name)})
+		}
+	}
+
+	resp := response{Href: href}
+	if len(found.Prop.Values) > 0 {
+		resp.Propstats = append(resp.Propstats, found)
+	}
+	if len(missing.Prop.Values) > 0 {
+		resp.Propstats = append(resp.Propstats, missing)
+	}
+
+	return resp
+}
+
+// elementName writes known namespaces with the prefixes declared on the
+// multistatus element.
+func elementName(name xml.Name) xml.Name {
+	if prefix, ok := prefixes[name.Space]; ok {
+		return xml.Name{Local: prefix + ":" + name.Local}
+	}
+
+	return name
+}
+
+func statusLine(code int) string {
+	return fmt.Sprintf("HTTP/1.1 %d %s", code, http.StatusText(code))
+}
+
+func href(path string) string {
+	return "<D:href>" + escape(path) + "</D:href>"
+}
+
+func escape(s string) string {
+	var b bytes.Buffer
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+func writeMultistatus(w http.ResponseWriter, ms *multistatus) error {
+	ms.D, ms.C, ms.CS = nsDAV, nsCalDAV, nsCS
+
+	body, err := xml.Marshal(ms)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	_, err = w.Write(append([]byte(xml.Header), body...))
+	return err
+}
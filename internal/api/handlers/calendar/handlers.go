@@ -0,0 +1,239 @@
+package calendar
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/middlewares"
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	calendarR "github.com/avraam311/calendar-service/internal/repository/calendar"
+	calendarS "github.com/avraam311/calendar-service/internal/service/calendar"
+)
+
+type Handler struct {
+	logger          *zap.Logger
+	validator       *validator.GoValidator
+	calendarService calendarService
+}
+
+func NewHandler(l *zap.Logger, v *validator.GoValidator, s calendarService) *Handler {
+	return &Handler{
+		logger:          l,
+		validator:       v,
+		calendarService: s,
+	}
+}
+
+func (h *Handler) CreateCalendar(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	var calendar *models.CalendarCreate
+	err := json.NewDecoder(r.Body).Decode(&calendar)
+	if err != nil || calendar == nil {
+		h.logger.Warn("failed to decode JSON", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	calendar.UserID = userID
+
+	err = h.validator.Validate(calendar)
+	if err != nil {
+		h.logger.Warn("validation error", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "validation error")
+		return
+	}
+
+	ID, err := h.calendarService.CreateCalendar(r.Context(), calendar)
+	if err != nil {
+		h.logger.Error("failed to create calendar", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	h.logger.Info("calendar created", zap.Int("user_id", userID), zap.Uint("calendar_id", ID))
+
+	h.writeResult(w, http.StatusCreated, ID)
+}
+
+func (h *Handler) GetCalendars(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	calendars, err := h.calendarService.GetCalendars(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to get calendars", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	h.writeResult(w, http.StatusOK, calendars)
+}
+
+func (h *Handler) GetMembers(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	calendarID, ok := h.calendarID(w, r)
+	if !ok {
+		return
+	}
+
+	members, err := h.calendarService.GetMembers(r.Context(), userID, calendarID)
+	if err != nil {
+		h.respondError(w, err, "failed to get calendar members")
+		return
+	}
+
+	h.writeResult(w, http.StatusOK, members)
+}
+
+// ShareCalendar grants a user access to the calendar or changes their role.
+func (h *Handler) ShareCalendar(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	calendarID, ok := h.calendarID(w, r)
+	if !ok {
+		return
+	}
+
+	var member *models.CalendarMember
+	err := json.NewDecoder(r.Body).Decode(&member)
+	if err != nil || member == nil {
+		h.logger.Warn("failed to decode JSON", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	member.CalendarID = calendarID
+
+	err = h.validator.Validate(member)
+	if err != nil {
+		h.logger.Warn("validation error", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "validation error")
+		return
+	}
+
+	err = h.calendarService.ShareCalendar(r.Context(), userID, member)
+	if err != nil {
+		h.respondError(w, err, "failed to share calendar")
+		return
+	}
+
+	h.logger.Info("calendar shared", zap.Uint("calendar_id", calendarID), zap.Int("member_id", member.UserID),
+		zap.String("role", member.Role))
+
+	h.writeResult(w, http.StatusOK, member)
+}
+
+func (h *Handler) RevokeAccess(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	calendarID, ok := h.calendarID(w, r)
+	if !ok {
+		return
+	}
+
+	memberID, err := strconv.Atoi(chi.URLParam(r, "user_id"))
+	if err != nil || memberID <= 0 {
+		h.logger.Warn("invalid member id", zap.String("user_id", chi.URLParam(r, "user_id")))
+		h.handleError(w, http.StatusBadRequest, "invalid user_id")
+		return
+	}
+
+	err = h.calendarService.RevokeAccess(r.Context(), userID, calendarID, memberID)
+	if err != nil {
+		h.respondError(w, err, "failed to revoke calendar access")
+		return
+	}
+
+	h.logger.Info("calendar access revoked", zap.Uint("calendar_id", calendarID), zap.Int("member_id", memberID))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) userID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	userID, ok := middlewares.UserID(r.Context())
+	if !ok {
+		h.logger.Warn("unauthenticated request")
+		h.handleError(w, http.StatusUnauthorized, "unauthorized")
+		return 0, false
+	}
+
+	return userID, true
+}
+
+func (h *Handler) calendarID(w http.ResponseWriter, r *http.Request) (uint, bool) {
+	ID, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 0)
+	if err != nil || ID == 0 {
+		h.logger.Warn("invalid calendar id", zap.String("id", chi.URLParam(r, "id")))
+		h.handleError(w, http.StatusBadRequest, "invalid calendar id")
+		return 0, false
+	}
+
+	return uint(ID), true
+}
+
+func (h *Handler) respondError(w http.ResponseWriter, err error, msg string) {
+	switch {
+	case errors.Is(err, calendarR.ErrCalendarNotFound):
+		h.logger.Warn("calendar not found")
+		h.handleError(w, http.StatusNotFound, "calendar not found")
+	case errors.Is(err, calendarR.ErrMemberNotFound):
+		h.logger.Warn("calendar member not found")
+		h.handleError(w, http.StatusNotFound, "calendar member not found")
+	case errors.Is(err, calendarS.ErrForbidden):
+		h.logger.Warn("calendar access denied", zap.Error(err))
+		h.handleError(w, http.StatusForbidden, "only calendar owners can manage access")
+	case errors.Is(err, calendarR.ErrLastOwner):
+		h.logger.Warn("last calendar owner", zap.Error(err))
+		h.handleError(w, http.StatusConflict, "calendar must keep at least one owner")
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+	}
+}
+
+func (h *Handler) writeResult(w http.ResponseWriter, code int, result any) {
+	response := map[string]any{
+		"result": result,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		h.logger.Error("failed to encode response", zap.Error(err))
+		http.Error(w, "response encoding error", http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) handleError(w http.ResponseWriter, code int, msg string) {
+	errorResponse := map[string]string{
+		"error": msg,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(errorResponse)
+	if err != nil {
+		h.logger.Error("failed to encode error response", zap.Error(err))
+		http.Error(w, "error response encoding error", http.StatusInternalServerError)
+	}
+}
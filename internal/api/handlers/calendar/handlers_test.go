@@ -0,0 +1,107 @@
+//go:build unit
+// +build unit
+
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/middlewares"
+	"github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	calendarR "github.com/avraam311/calendar-service/internal/repository/calendar"
+	calendarS "github.com/avraam311/calendar-service/internal/service/calendar"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocks.MockcalendarService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocks.NewMockcalendarService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	return ctrl, mockService, NewHandler(logger, validator.New(), mockService)
+}
+
+// newRequest builds a request authenticated as user 1 with the given route
+// parameters.
+func newRequest(method, target, body string, params map[string]string) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	rctx := chi.NewRouteContext()
+	for k, v := range params {
+		rctx.URLParams.Add(k, v)
+	}
+	ctx := context.WithValue(middlewares.ContextWithUserID(req.Context(), 1), chi.RouteCtxKey, rctx)
+	return req.WithContext(ctx)
+}
+
+func TestHandlerShareCalendar(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		ShareCalendar(gomock.Any(), 1, &models.CalendarMember{CalendarID: 7, UserID: 2, Role: "viewer"}).
+		Return(nil)
+
+	w := httptest.NewRecorder()
+	h.ShareCalendar(w, newRequest(http.MethodPut, "/calendars/7/members", `{"user_id": 2, "role": "viewer"}`,
+		map[string]string{"id": "7"}))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandlerShareCalendarInvalidRole(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.ShareCalendar(w, newRequest(http.MethodPut, "/calendars/7/members", `{"user_id": 2, "role": "admin"}`,
+		map[string]string{"id": "7"}))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerShareCalendarForbidden(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		ShareCalendar(gomock.Any(), 1, gomock.Any()).
+		Return(fmt.Errorf("service/ShareCalendar - %w", calendarS.ErrForbidden))
+
+	w := httptest.NewRecorder()
+	h.ShareCalendar(w, newRequest(http.MethodPut, "/calendars/7/members", `{"user_id": 2, "role": "editor"}`,
+		map[string]string{"id": "7"}))
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
+
+func TestHandlerRevokeLastOwner(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		RevokeAccess(gomock.Any(), 1, uint(7), 1).
+		Return(fmt.Errorf("service/RevokeAccess - %w", calendarR.ErrLastOwner))
+
+	w := httptest.NewRecorder()
+	h.RevokeAccess(w, newRequest(http.MethodDelete, "/calendars/7/members/1", "",
+		map[string]string{"id": "7", "user_id": "1"}))
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+}
@@ -0,0 +1,16 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+//go:generate mockgen -source=interface.go -destination=../../../mocks/mock_calendar_handlers.go -package=mocks
+type calendarService interface {
+	CreateCalendar(ctx context.Context, calendar *models.CalendarCreate) (uint, error)
+	GetCalendars(ctx context.Context, userID int) ([]*models.Calendar, error)
+	GetMembers(ctx context.Context, userID int, calendarID uint) ([]*models.CalendarMember, error)
+	ShareCalendar(ctx context.Context, userID int, member *models.CalendarMember) error
+	RevokeAccess(ctx context.Context, userID int, calendarID uint, memberID int) error
+}
@@ -0,0 +1,227 @@
+package event
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/middlewares"
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	eventR "github.com/avraam311/calendar-service/internal/repository/event"
+)
+
+type AttendeeHandler struct {
+	logger       *zap.Logger
+	validator    *validator.GoValidator
+	eventService eventService
+}
+
+func NewAttendeeHandler(l *zap.Logger, v *validator.GoValidator, s eventService) *AttendeeHandler {
+	return &AttendeeHandler{
+		logger:       l,
+		validator:    v,
+		eventService: s,
+	}
+}
+
+// InviteAttendees invites users to the event and responds with the updated
+// attendee list.
+func (h *AttendeeHandler) InviteAttendees(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	eventID, ok := h.eventID(w, r)
+	if !ok {
+		return
+	}
+
+	var invite *models.EventInvite
+	err := json.NewDecoder(r.Body).Decode(&invite)
+	if err != nil || invite == nil {
+		h.logger.Warn("failed to decode JSON", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	invite.EventID = eventID
+	invite.UserID = userID
+
+	err = h.validator.Validate(invite)
+	if err != nil {
+		h.logger.Warn("validation error", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "validation error")
+		return
+	}
+
+	attendees, err := h.eventService.InviteAttendees(r.Context(), invite)
+	if err != nil {
+		h.respondError(w, err, "failed to invite attendees")
+		return
+	}
+
+	h.logger.Info("attendees invited", zap.Uint("event_id", eventID), zap.Ints("user_ids", invite.UserIDs))
+
+	h.writeResult(w, http.StatusOK, attendees)
+}
+
+// RespondToEvent records the caller's answer to an invitation.
+func (h *AttendeeHandler) RespondToEvent(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	eventID, ok := h.eventID(w, r)
+	if !ok {
+		return
+	}
+
+	var rsvp *models.EventRSVP
+	err := json.NewDecoder(r.Body).Decode(&rsvp)
+	if err != nil || rsvp == nil {
+		h.logger.Warn("failed to decode JSON", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	rsvp.EventID = eventID
+	rsvp.UserID = userID
+
+	err = h.validator.Validate(rsvp)
+	if err != nil {
+		h.logger.Warn("validation error", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "validation error")
+		return
+	}
+
+	err = h.eventService.RespondToEvent(r.Context(), rsvp)
+	if err != nil {
+		h.respondError(w, err, "failed to respond to event")
+		return
+	}
+
+	h.logger.Info("event invitation answered", zap.Uint("event_id", eventID), zap.Int("user_id", userID),
+		zap.String("status", rsvp.Status))
+
+	h.writeResult(w, http.StatusOK, &models.Attendee{UserID: userID, Status: rsvp.Status})
+}
+
+func (h *AttendeeHandler) RemoveAttendee(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	eventID, ok := h.eventID(w, r)
+	if !ok {
+		return
+	}
+
+	attendeeID, err := strconv.Atoi(chi.URLParam(r, "user_id"))
+	if err != nil || attendeeID <= 0 {
+		h.logger.Warn("invalid attendee id", zap.String("user_id", chi.URLParam(r, "user_id")))
+		h.handleError(w, http.StatusBadRequest, "invalid user_id")
+		return
+	}
+
+	err = h.eventService.RemoveAttendee(r.Context(), userID, eventID, attendeeID)
+	if err != nil {
+		h.respondError(w, err, "failed to remove attendee")
+		return
+	}
+
+	h.logger.Info("attendee removed", zap.Uint("event_id", eventID), zap.Int("attendee_id", attendeeID))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AttendeeHandler) GetAttendees(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	eventID, ok := h.eventID(w, r)
+	if !ok {
+		return
+	}
+
+	attendees, err := h.eventService.GetAttendees(r.Context(), userID, eventID)
+	if err != nil {
+		h.respondError(w, err, "failed to get attendees")
+		return
+	}
+
+	h.writeResult(w, http.StatusOK, attendees)
+}
+
+func (h *AttendeeHandler) userID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	userID, ok := middlewares.UserID(r.Context())
+	if !ok {
+		h.logger.Warn("unauthenticated request")
+		h.handleError(w, http.StatusUnauthorized, "unauthorized")
+		return 0, false
+	}
+
+	return userID, true
+}
+
+func (h *AttendeeHandler) eventID(w http.ResponseWriter, r *http.Request) (uint, bool) {
+	ID, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 0)
+	if err != nil || ID == 0 {
+		h.logger.Warn("invalid event id", zap.String("id", chi.URLParam(r, "id")))
+		h.handleError(w, http.StatusBadRequest, "invalid event id")
+		return 0, false
+	}
+
+	return uint(ID), true
+}
+
+func (h *AttendeeHandler) respondError(w http.ResponseWriter, err error, msg string) {
+	switch {
+	case errors.Is(err, eventR.ErrEventNotFound):
+		h.logger.Warn("event not found")
+		h.handleError(w, http.StatusNotFound, "event not found")
+	case errors.Is(err, eventR.ErrAttendeeNotFound):
+		h.logger.Warn("attendee not found")
+		h.handleError(w, http.StatusNotFound, "invitation not found")
+	case errors.Is(err, eventR.ErrForbidden):
+		h.logger.Warn("event access denied", zap.Error(err))
+		h.handleError(w, http.StatusForbidden, "forbidden")
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+	}
+}
+
+func (h *AttendeeHandler) writeResult(w http.ResponseWriter, code int, result any) {
+	response := map[string]any{
+		"result": result,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		h.logger.Error("failed to encode response", zap.Error(err))
+		http.Error(w, "response encoding error", http.StatusInternalServerError)
+	}
+}
+
+func (h *AttendeeHandler) handleError(w http.ResponseWriter, code int, msg string) {
+	errorResponse := map[string]string{
+		"error": msg,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(errorResponse)
+	if err != nil {
+		h.logger.Error("failed to encode error response", zap.Error(err))
+		http.Error(w, "error response encoding error", http.StatusInternalServerError)
+	}
+}
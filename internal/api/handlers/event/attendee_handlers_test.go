@@ -0,0 +1,116 @@
+//go:build unit
+// +build unit
+
+package event
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	mockEventS "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	eventR "github.com/avraam311/calendar-service/internal/repository/event"
+)
+
+func setupAttendeeHandler(t *testing.T) (*gomock.Controller, *mockEventS.MockeventService, *AttendeeHandler) {
+	ctrl := gomock.NewController(t)
+	mockService := mockEventS.NewMockeventService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	return ctrl, mockService, NewAttendeeHandler(logger, validator.New(), mockService)
+}
+
+// newRouteRequest builds a request authenticated as user 1 with the given
+// route parameters.
+func newRouteRequest(method, target, body string, params map[string]string) *http.Request {
+	req := newRequest(method, target, strings.NewReader(body))
+	rctx := chi.NewRouteContext()
+	for k, v := range params {
+		rctx.URLParams.Add(k, v)
+	}
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestHandlerInviteAttendees(t *testing.T) {
+	ctrl, mockService, h := setupAttendeeHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		InviteAttendees(gomock.Any(), &models.EventInvite{EventID: 7, UserID: 1, UserIDs: []int{2, 3}}).
+		Return([]*models.Attendee{{UserID: 2, Status: models.AttendeeNeedsAction}}, nil)
+
+	w := httptest.NewRecorder()
+	h.InviteAttendees(w, newRouteRequest(http.MethodPut, "/events/7/attendees", `{"user_ids": [2, 3]}`,
+		map[string]string{"id": "7"}))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandlerInviteAttendeesForbidden(t *testing.T) {
+	ctrl, mockService, h := setupAttendeeHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		InviteAttendees(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("service/InviteAttendees - %w", eventR.ErrForbidden))
+
+	w := httptest.NewRecorder()
+	h.InviteAttendees(w, newRouteRequest(http.MethodPut, "/events/7/attendees", `{"user_ids": [2]}`,
+		map[string]string{"id": "7"}))
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
+
+func TestHandlerRespondToEventInvalidStatus(t *testing.T) {
+	ctrl, _, h := setupAttendeeHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.RespondToEvent(w, newRouteRequest(http.MethodPut, "/events/7/rsvp", `{"status": "needs-action"}`,
+		map[string]string{"id": "7"}))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerRespondToEventNotInvited(t *testing.T) {
+	ctrl, mockService, h := setupAttendeeHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		RespondToEvent(gomock.Any(), &models.EventRSVP{EventID: 7, UserID: 1, Status: models.AttendeeAccepted}).
+		Return(fmt.Errorf("service/RespondToEvent - %w", eventR.ErrAttendeeNotFound))
+
+	w := httptest.NewRecorder()
+	h.RespondToEvent(w, newRouteRequest(http.MethodPut, "/events/7/rsvp", `{"status": "accepted"}`,
+		map[string]string{"id": "7"}))
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandlerRemoveAttendeeUnauthorized(t *testing.T) {
+	ctrl, _, h := setupAttendeeHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.RemoveAttendee(w, httptest.NewRequest(http.MethodDelete, "/events/7/attendees/2", nil))
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
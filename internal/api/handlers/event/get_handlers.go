@@ -1,155 +1,252 @@
 package event
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/avraam311/calendar-service/internal/middlewares"
 	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/ical"
+	"github.com/avraam311/calendar-service/internal/pkg/period"
 	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	eventS "github.com/avraam311/calendar-service/internal/service/event"
 )
 
 type GetHandler struct {
 	logger       *zap.Logger
 	validator    *validator.GoValidator
 	eventService eventService
+	weekStart    time.Weekday
 }
 
-func NewGetHandler(l *zap.Logger, v *validator.GoValidator, s eventService) *GetHandler {
+func NewGetHandler(l *zap.Logger, v *validator.GoValidator, s eventService, weekStart time.Weekday) *GetHandler {
 	return &GetHandler{
 		logger:       l,
 		eventService: s,
 		validator:    v,
+		weekStart:    weekStart,
 	}
 }
 
 func (h *GetHandler) GetEventsForDay(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		h.logger.Warn("not allowed methods")
-		h.handleError(w, http.StatusBadRequest, "only method GET allowed")
-		return
-	}
+	h.getEventsForPeriod(w, r, func(date time.Time) (time.Time, time.Time) {
+		return period.Day(date)
+	})
+}
 
-	var UserID *models.EventGetUserID
-	err := json.NewDecoder(r.Body).Decode(&UserID)
-	if err != nil {
-		h.logger.Warn("failed to decode JSON", zap.Error(err))
-		h.handleError(w, http.StatusBadRequest, "invalid json")
-		return
+func (h *GetHandler) GetEventsForWeek(w http.ResponseWriter, r *http.Request) {
+	weekStart := h.weekStart
+	if s := r.URL.Query().Get("week_start"); s != "" {
+		var err error
+		weekStart, err = period.ParseWeekday(s)
+		if err != nil {
+			h.logger.Warn("failed to parse week start", zap.Error(err))
+			h.handleError(w, http.StatusBadRequest, "invalid week_start in query string")
+			return
+		}
 	}
 
-	err = h.validator.Validate(UserID)
-	if err != nil {
-		h.logger.Warn("validation error", zap.Error(err))
-		h.handleError(w, http.StatusBadRequest, "validation error")
+	h.getEventsForPeriod(w, r, func(date time.Time) (time.Time, time.Time) {
+		return period.Week(date, weekStart)
+	})
+}
+
+func (h *GetHandler) GetEventsForMonth(w http.ResponseWriter, r *http.Request) {
+	h.getEventsForPeriod(w, r, func(date time.Time) (time.Time, time.Time) {
+		return period.Month(date)
+	})
+}
+
+func (h *GetHandler) GetEventsForYear(w http.ResponseWriter, r *http.Request) {
+	h.getEventsForPeriod(w, r, func(date time.Time) (time.Time, time.Time) {
+		return period.Year(date)
+	})
+}
+
+// GetEvents serves the events of an arbitrary [from, to) window, filtered,
+// sorted and paged by the query parameters read by getEvents.
+func (h *GetHandler) GetEvents(w http.ResponseWriter, r *http.Request) {
+	eventGet, ok := h.parseRange(w, r)
+	if !ok {
 		return
 	}
 
-	dateStr := r.URL.Query().Get("date")
-	if dateStr == "" {
-		h.logger.Warn("missing date", zap.String("date", dateStr))
-		h.handleError(w, http.StatusBadRequest, "query string \"date\" is empty")
+	h.getEvents(w, r, eventGet)
+}
+
+// GetEventsForRange is kept for existing clients of /events_for_range.
+func (h *GetHandler) GetEventsForRange(w http.ResponseWriter, r *http.Request) {
+	h.GetEvents(w, r)
+}
+
+func (h *GetHandler) GetArchivedEvents(w http.ResponseWriter, r *http.Request) {
+	eventGet, ok := h.parseRange(w, r)
+	if !ok {
 		return
 	}
 
-	layout := "2006-01-02T15:04:05Z"
-	dateFrom, err := time.Parse(layout, dateStr)
+	events, err := h.eventService.GetArchivedEvents(r.Context(), eventGet)
 	if err != nil {
-		h.logger.Warn("failed to parse date", zap.Error(err))
-		h.handleError(w, http.StatusBadRequest, "invalid data in query string")
+		h.logger.Error("failed to get archived events", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
 		return
 	}
 
-	dateTo := dateFrom.Add(time.Hour * 24)
+	h.logger.Info("archived events got", zap.Int("count", len(events)))
 
-	getEvent := &models.EventGet{
-		UserID:   UserID.UserID,
-		DateFrom: dateFrom,
-		DateTo:   dateTo,
+	h.writeEvents(w, events)
+}
+
+// ExportEvents serves the events of the range as an iCalendar file that can
+// be imported into other calendar applications.
+func (h *GetHandler) ExportEvents(w http.ResponseWriter, r *http.Request) {
+	eventGet, ok := h.parseRange(w, r)
+	if !ok {
+		return
 	}
 
-	var events []*models.Event
-	events, err = h.eventService.GetEvents(r.Context(), getEvent)
+	events, err := h.eventService.GetEvents(r.Context(), eventGet)
 	if err != nil {
 		h.logger.Error("failed to get events", zap.Error(err))
 		h.handleError(w, http.StatusInternalServerError, "internal error")
 		return
 	}
 
-	h.logger.Info("events got", zap.Any("events", events))
-
-	response := map[string][]*models.Event{
-		"result": events,
+	var buf bytes.Buffer
+	if err = ical.Encode(&buf, events, time.Now()); err != nil {
+		h.logger.Error("failed to encode calendar", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
+	h.logger.Info("events exported", zap.Int("count", len(events)))
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="calendar.ics"`)
 	w.WriteHeader(http.StatusOK)
-	err = json.NewEncoder(w).Encode(response)
-	if err != nil {
-		h.logger.Error("failed to encode error response", zap.Error(err))
-		http.Error(w, "error response encoding error", http.StatusInternalServerError)
+	if _, err = buf.WriteTo(w); err != nil {
+		h.logger.Error("failed to write calendar", zap.Error(err))
 	}
 }
 
-func (h *GetHandler) GetEventsForWeek(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		h.logger.Warn("not allowed methods")
-		h.handleError(w, http.StatusBadRequest, "only method GET allowed")
+// SearchEvents finds the user's events matching the "q" query parameter,
+// optionally within the "from" and "to" bounds.
+func (h *GetHandler) SearchEvents(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
 		return
 	}
 
-	var UserID *models.EventGetUserID
-	err := json.NewDecoder(r.Body).Decode(&UserID)
-	if err != nil {
-		h.logger.Warn("failed to decode JSON", zap.Error(err))
-		h.handleError(w, http.StatusBadRequest, "invalid json")
+	loc, ok := h.parseLocation(w, r)
+	if !ok {
 		return
 	}
 
-	err = h.validator.Validate(UserID)
-	if err != nil {
+	search := &models.EventSearch{UserID: userID, Query: r.URL.Query().Get("q")}
+	if r.URL.Query().Get("from") != "" {
+		if search.DateFrom, ok = h.parseDate(w, r, "from", loc); !ok {
+			return
+		}
+	}
+	if r.URL.Query().Get("to") != "" {
+		if search.DateTo, ok = h.parseDate(w, r, "to", loc); !ok {
+			return
+		}
+	}
+
+	if !search.DateFrom.IsZero() && !search.DateTo.IsZero() && !search.DateTo.After(search.DateFrom) {
+		h.logger.Warn("invalid range", zap.Time("from", search.DateFrom), zap.Time("to", search.DateTo))
+		h.handleError(w, http.StatusBadRequest, "query string \"to\" must be after \"from\"")
+		return
+	}
+
+	if s := r.URL.Query().Get("limit"); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil {
+			h.logger.Warn("invalid limit", zap.String("limit", s))
+			h.handleError(w, http.StatusBadRequest, "invalid limit in query string")
+			return
+		}
+		search.Limit = limit
+	}
+
+	if err := h.validator.Validate(search); err != nil {
 		h.logger.Warn("validation error", zap.Error(err))
 		h.handleError(w, http.StatusBadRequest, "validation error")
 		return
 	}
 
-	dateStr := r.URL.Query().Get("date")
-	if dateStr == "" {
-		h.logger.Warn("missing date", zap.String("date", dateStr))
-		h.handleError(w, http.StatusBadRequest, "query string \"date\" is empty")
+	events, err := h.eventService.SearchEvents(r.Context(), search)
+	if err != nil {
+		if errors.Is(err, eventS.ErrEmptySearchQuery) {
+			h.logger.Warn("empty search query", zap.String("q", search.Query))
+			h.handleError(w, http.StatusBadRequest, "search query has no words")
+			return
+		}
+
+		h.logger.Error("failed to search events", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
 		return
 	}
 
-	layout := "2006-01-02T15:04:05Z"
-	dateFrom, err := time.Parse(layout, dateStr)
-	if err != nil {
-		h.logger.Warn("failed to parse date", zap.Error(err))
-		h.handleError(w, http.StatusBadRequest, "invalid data in query string")
+	h.logger.Info("events found", zap.Int("count", len(events)))
+
+	h.writeEvents(w, events)
+}
+
+// FreeBusy reports when the users listed in "user_ids" are busy within the
+// range; without the parameter it reports on the caller. Only users sharing a
+// calendar with the caller may be listed.
+func (h *GetHandler) FreeBusy(w http.ResponseWriter, r *http.Request) {
+	eventGet, ok := h.parseRange(w, r)
+	if !ok {
 		return
 	}
 
-	dateTo := dateFrom.Add(time.Hour * 24 * 7)
+	query := &models.FreeBusyQuery{
+		UserID:   eventGet.UserID,
+		UserIDs:  []int{eventGet.UserID},
+		DateFrom: eventGet.DateFrom,
+		DateTo:   eventGet.DateTo,
+	}
+	if s := r.URL.Query().Get("user_ids"); s != "" {
+		userIDs, err := parseIDs[int](s)
+		if err != nil {
+			h.logger.Warn("invalid user id", zap.String("user_ids", s))
+			h.handleError(w, http.StatusBadRequest, "invalid user_ids in query string")
+			return
+		}
+		query.UserIDs = userIDs
+	}
 
-	getEvent := &models.EventGet{
-		UserID:   UserID.UserID,
-		DateFrom: dateFrom,
-		DateTo:   dateTo,
+	if err := h.validator.Validate(query); err != nil {
+		h.logger.Warn("validation error", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "validation error")
+		return
 	}
 
-	var events []*models.Event
-	events, err = h.eventService.GetEvents(r.Context(), getEvent)
+	freeBusy, err := h.eventService.FreeBusy(r.Context(), query)
+	if errors.Is(err, eventS.ErrNotCalendarPeer) {
+		h.logger.Warn("free/busy of a user outside shared calendars", zap.Ints("user_ids", query.UserIDs))
+		h.handleError(w, http.StatusForbidden, "user_ids must share a calendar with you")
+		return
+	}
 	if err != nil {
-		h.logger.Error("failed to get events", zap.Error(err))
+		h.logger.Error("failed to get free/busy", zap.Error(err))
 		h.handleError(w, http.StatusInternalServerError, "internal error")
 		return
 	}
 
-	h.logger.Info("events got", zap.Any("events", events))
-
-	response := map[string][]*models.Event{
-		"result": events,
+	response := map[string][]*models.FreeBusy{
+		"result": freeBusy,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -161,74 +258,278 @@ func (h *GetHandler) GetEventsForWeek(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (h *GetHandler) GetEventsForMonth(w http.ResponseWriter, r *http.Request) {
+// parseRange reads the user and the arbitrary [from, to) window of the range
+// endpoints.
+func (h *GetHandler) parseRange(w http.ResponseWriter, r *http.Request) (*models.EventGet, bool) {
 	if r.Method != http.MethodGet {
 		h.logger.Warn("not allowed methods")
 		h.handleError(w, http.StatusBadRequest, "only method GET allowed")
+		return nil, false
+	}
+
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	loc, ok := h.parseLocation(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	dateFrom, ok := h.parseDate(w, r, "from", loc)
+	if !ok {
+		return nil, false
+	}
+
+	dateTo, ok := h.parseDate(w, r, "to", loc)
+	if !ok {
+		return nil, false
+	}
+
+	if !dateTo.After(dateFrom) {
+		h.logger.Warn("invalid range", zap.Time("from", dateFrom), zap.Time("to", dateTo))
+		h.handleError(w, http.StatusBadRequest, "query string \"to\" must be after \"from\"")
+		return nil, false
+	}
+
+	return &models.EventGet{
+		UserID:   userID,
+		DateFrom: dateFrom,
+		DateTo:   dateTo,
+	}, true
+}
+
+// getEventsForPeriod serves the fixed-period endpoints: the calendar period
+// containing the "date" query parameter is computed by window.
+func (h *GetHandler) getEventsForPeriod(w http.ResponseWriter, r *http.Request,
+	window func(time.Time) (time.Time, time.Time)) {
+	if r.Method != http.MethodGet {
+		h.logger.Warn("not allowed methods")
+		h.handleError(w, http.StatusBadRequest, "only method GET allowed")
+		return
+	}
+
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	loc, ok := h.parseLocation(w, r)
+	if !ok {
+		return
+	}
+
+	date, ok := h.parseDate(w, r, "date", loc)
+	if !ok {
 		return
 	}
 
-	var UserID *models.EventGetUserID
-	err := json.NewDecoder(r.Body).Decode(&UserID)
+	dateFrom, dateTo := window(date)
+
+	h.getEvents(w, r, &models.EventGet{
+		UserID:   userID,
+		DateFrom: dateFrom,
+		DateTo:   dateTo,
+	})
+}
+
+// getEvents serves a page of the events of the window. The query parameters
+// narrow the events down:
+//   - "user_ids" and "calendar_ids" to the organizers and calendars listed;
+//   - "tags" and "exclude_tags" by the names of the caller's tags;
+//   - "q" by a full-text query.
+//
+// "sort" orders the events by date, "-date" for the latest first. Pages are
+// requested with "limit" and the "cursor" of the previous page, and "fields"
+// lists the event fields to respond with.
+func (h *GetHandler) getEvents(w http.ResponseWriter, r *http.Request, getEvent *models.EventGet) {
+	query := r.URL.Query()
+	getEvent.Tags = splitList(query.Get("tags"))
+	getEvent.ExcludeTags = splitList(query.Get("exclude_tags"))
+	getEvent.Query = query.Get("q")
+	getEvent.Sort = query.Get("sort")
+	getEvent.Cursor = query.Get("cursor")
+
+	var err error
+	getEvent.OwnerIDs, err = parseIDs[int](query.Get("user_ids"))
 	if err != nil {
-		h.logger.Warn("failed to decode JSON", zap.Error(err))
-		h.handleError(w, http.StatusBadRequest, "invalid json")
+		h.logger.Warn("invalid user id", zap.String("user_ids", query.Get("user_ids")))
+		h.handleError(w, http.StatusBadRequest, "invalid user_ids in query string")
 		return
 	}
 
-	err = h.validator.Validate(UserID)
+	getEvent.CalendarIDs, err = parseIDs[uint](query.Get("calendar_ids"))
 	if err != nil {
-		h.logger.Warn("validation error", zap.Error(err))
-		h.handleError(w, http.StatusBadRequest, "validation error")
+		h.logger.Warn("invalid calendar id", zap.String("calendar_ids", query.Get("calendar_ids")))
+		h.handleError(w, http.StatusBadRequest, "invalid calendar_ids in query string")
 		return
 	}
 
-	dateStr := r.URL.Query().Get("date")
-	if dateStr == "" {
-		h.logger.Warn("missing date", zap.String("date", dateStr))
-		h.handleError(w, http.StatusBadRequest, "query string \"date\" is empty")
+	if s := query.Get("limit"); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil {
+			h.logger.Warn("invalid limit", zap.String("limit", s))
+			h.handleError(w, http.StatusBadRequest, "invalid limit in query string")
+			return
+		}
+		getEvent.Limit = limit
+	}
+
+	fields := splitList(query.Get("fields"))
+	for _, field := range fields {
+		if !eventFields[field] {
+			h.logger.Warn("invalid field", zap.String("fields", query.Get("fields")))
+			h.handleError(w, http.StatusBadRequest, fmt.Sprintf("unknown field %q in query string", field))
+			return
+		}
+	}
+
+	if err := h.validator.Validate(getEvent); err != nil {
+		h.logger.Warn("validation error", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "validation error")
 		return
 	}
 
-	layout := "2006-01-02T15:04:05Z"
-	dateFrom, err := time.Parse(layout, dateStr)
+	page, err := h.eventService.GetEventsPage(r.Context(), getEvent)
 	if err != nil {
-		h.logger.Warn("failed to parse date", zap.Error(err))
-		h.handleError(w, http.StatusBadRequest, "invalid data in query string")
+		switch {
+		case errors.Is(err, eventS.ErrInvalidCursor):
+			h.logger.Warn("invalid cursor", zap.String("cursor", getEvent.Cursor))
+			h.handleError(w, http.StatusBadRequest, "invalid cursor in query string")
+		case errors.Is(err, eventS.ErrEmptySearchQuery):
+			h.logger.Warn("empty search query", zap.String("q", getEvent.Query))
+			h.handleError(w, http.StatusBadRequest, "search query has no words")
+		default:
+			h.logger.Error("failed to get events", zap.Error(err))
+			h.handleError(w, http.StatusInternalServerError, "internal error")
+		}
 		return
 	}
 
-	dateTo := dateFrom.Add(time.Hour * 24 * 30)
+	h.logger.Info("events got", zap.Int("count", len(page.Events)), zap.Bool("more", page.NextCursor != ""))
 
-	getEvent := &models.EventGet{
-		UserID:   UserID.UserID,
-		DateFrom: dateFrom,
-		DateTo:   dateTo,
+	var response any = page
+	if len(fields) > 0 {
+		response, err = selectFields(page, fields)
+		if err != nil {
+			h.logger.Error("failed to select fields", zap.Error(err))
+			h.handleError(w, http.StatusInternalServerError, "internal error")
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		h.logger.Error("failed to encode response", zap.Error(err))
+		http.Error(w, "response encoding error", http.StatusInternalServerError)
 	}
+}
 
-	var events []*models.Event
-	events, err = h.eventService.GetEvents(r.Context(), getEvent)
+// TagSummary counts the caller's events within the range per tag.
+func (h *GetHandler) TagSummary(w http.ResponseWriter, r *http.Request) {
+	eventGet, ok := h.parseRange(w, r)
+	if !ok {
+		return
+	}
+
+	summary, err := h.eventService.TagSummary(r.Context(), eventGet)
 	if err != nil {
-		h.logger.Error("failed to get events", zap.Error(err))
+		h.logger.Error("failed to get tag summary", zap.Error(err))
 		h.handleError(w, http.StatusInternalServerError, "internal error")
 		return
 	}
 
-	h.logger.Info("events got", zap.Any("events", events))
+	response := map[string][]*models.TagCount{
+		"result": summary,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		h.logger.Error("failed to encode response", zap.Error(err))
+		http.Error(w, "response encoding error", http.StatusInternalServerError)
+	}
+}
 
+func (h *GetHandler) writeEvents(w http.ResponseWriter, events []*models.Event) {
 	response := map[string][]*models.Event{
 		"result": events,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	err = json.NewEncoder(w).Encode(response)
+	err := json.NewEncoder(w).Encode(response)
 	if err != nil {
 		h.logger.Error("failed to encode error response", zap.Error(err))
 		http.Error(w, "error response encoding error", http.StatusInternalServerError)
 	}
 }
 
+func (h *GetHandler) userID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	userID, ok := middlewares.UserID(r.Context())
+	if !ok {
+		h.logger.Warn("unauthenticated request")
+		h.handleError(w, http.StatusUnauthorized, "unauthorized")
+		return 0, false
+	}
+
+	return userID, true
+}
+
+func (h *GetHandler) parseLocation(w http.ResponseWriter, r *http.Request) (*time.Location, bool) {
+	tz := r.URL.Query().Get("tz")
+	if tz == "" {
+		return time.UTC, true
+	}
+
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		h.logger.Warn("failed to load time zone", zap.String("tz", tz), zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "invalid time zone in query string")
+		return nil, false
+	}
+
+	return loc, true
+}
+
+// parseDate reads a query parameter as wall-clock time in loc, so window
+// boundaries follow that zone's calendar, including DST transitions. A plain
+// date is read as its midnight. A time with a UTC offset is an instant: it is
+// moved to the zone of "tz" if given, otherwise windows follow its offset.
+func (h *GetHandler) parseDate(w http.ResponseWriter, r *http.Request, name string,
+	loc *time.Location) (time.Time, bool) {
+	dateStr := r.URL.Query().Get(name)
+	if dateStr == "" {
+		h.logger.Warn("missing date", zap.String(name, dateStr))
+		h.handleError(w, http.StatusBadRequest, fmt.Sprintf("query string %q is empty", name))
+		return time.Time{}, false
+	}
+
+	for _, layout := range []string{"2006-01-02T15:04:05", time.DateOnly} {
+		date, err := time.ParseInLocation(layout, dateStr, loc)
+		if err == nil {
+			return date, true
+		}
+	}
+
+	// An unescaped "+" of the offset arrives as a space.
+	date, err := time.Parse(time.RFC3339, strings.Replace(dateStr, " ", "+", 1))
+	if err == nil {
+		if r.URL.Query().Has("tz") {
+			date = date.In(loc)
+		}
+		return date, true
+	}
+
+	h.logger.Warn("failed to parse date", zap.String(name, dateStr))
+	h.handleError(w, http.StatusBadRequest, "invalid data in query string")
+	return time.Time{}, false
+}
+
 func (h *GetHandler) handleError(w http.ResponseWriter, code int, msg string) {
 	errorResponse := map[string]string{
 		"error": msg,
@@ -241,3 +542,70 @@ func (h *GetHandler) handleError(w http.ResponseWriter, code int, msg string) {
 		http.Error(w, "error response encoding error", http.StatusInternalServerError)
 	}
 }
+
+// splitList splits a comma-separated query parameter, dropping empty items.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// parseIDs parses a comma-separated list of positive IDs.
+func parseIDs[T int | uint](s string) ([]T, error) {
+	var IDs []T
+	for _, item := range splitList(s) {
+		ID, err := strconv.ParseUint(item, 10, 63)
+		if err != nil || ID == 0 {
+			return nil, fmt.Errorf("invalid id %q", item)
+		}
+		IDs = append(IDs, T(ID))
+	}
+
+	return IDs, nil
+}
+
+// eventFields are the fields of models.Event a response can be narrowed to.
+var eventFields = map[string]bool{
+	"id": true, "user_id": true, "calendar_id": true, "title": true, "description": true, "location": true,
+	"url": true, "metadata": true, "date": true, "end_date": true, "all_day": true, "time_zone": true,
+	"reminders": true, "rrule": true, "exdates": true, "recurrence_id": true, "rsvp_status": true, "tags": true,
+	"outside_working_hours": true,
+}
+
+// selectFields narrows the events of the page down to the fields; the ID is
+// always kept. Fields omitted from an event's JSON stay omitted.
+func selectFields(page *models.EventPage, fields []string) (map[string]any, error) {
+	events := make([]map[string]json.RawMessage, 0, len(page.Events))
+	for _, e := range page.Events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+
+		var all map[string]json.RawMessage
+		if err := json.Unmarshal(data, &all); err != nil {
+			return nil, err
+		}
+
+		selected := map[string]json.RawMessage{"id": all["id"]}
+		for _, field := range fields {
+			if value, ok := all[field]; ok {
+				selected[field] = value
+			}
+		}
+
+		events = append(events, selected)
+	}
+
+	response := map[string]any{"result": events}
+	if page.NextCursor != "" {
+		response["next_cursor"] = page.NextCursor
+	}
+
+	return response, nil
+}
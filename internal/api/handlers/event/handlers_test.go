@@ -8,8 +8,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,10 +19,12 @@ import (
 	"github.com/golang/mock/gomock"
 	"go.uber.org/zap"
 
+	"github.com/avraam311/calendar-service/internal/middlewares"
 	mockEventS "github.com/avraam311/calendar-service/internal/mocks"
 	"github.com/avraam311/calendar-service/internal/models"
 	"github.com/avraam311/calendar-service/internal/pkg/validator"
 	eventR "github.com/avraam311/calendar-service/internal/repository/event"
+	eventS "github.com/avraam311/calendar-service/internal/service/event"
 )
 
 func setupPostHandler(t *testing.T) (*gomock.Controller, *mockEventS.MockeventService, *PostHandler) {
@@ -37,10 +41,16 @@ func setupGetHandler(t *testing.T) (*gomock.Controller, *mockEventS.MockeventSer
 	mockService := mockEventS.NewMockeventService(ctrl)
 	logger, _ := zap.NewDevelopment()
 	validate := validator.New()
-	handler := NewGetHandler(logger, validate, mockService)
+	handler := NewGetHandler(logger, validate, mockService, time.Monday)
 	return ctrl, mockService, handler
 }
 
+// newRequest builds a request authenticated as user 1.
+func newRequest(method, target string, body io.Reader) *http.Request {
+	req := httptest.NewRequest(method, target, body)
+	return req.WithContext(middlewares.ContextWithUserID(req.Context(), 1))
+}
+
 func TestHandlerCreateSuccess(t *testing.T) {
 	ctrl, mockService, h := setupPostHandler(t)
 	defer ctrl.Finish()
@@ -48,17 +58,17 @@ func TestHandlerCreateSuccess(t *testing.T) {
 	userID := 1
 	reqBody := models.EventCreate{
 		UserID: userID,
-		Event:  "Test Event",
+		Title:  "Test Event",
 		Date:   time.Now(),
 	}
 	body, _ := json.Marshal(reqBody)
 
-	req := httptest.NewRequest(http.MethodPost, "/create_event", bytes.NewReader(body))
+	req := newRequest(http.MethodPost, "/create_event", bytes.NewReader(body))
 	w := httptest.NewRecorder()
 
 	mockService.EXPECT().
 		CreateEvent(gomock.Any(), gomock.Any()).
-		Return(uint(1), nil)
+		Return(uint(1), nil, nil)
 
 	h.CreateEvent(w, req)
 
@@ -67,11 +77,53 @@ func TestHandlerCreateSuccess(t *testing.T) {
 	}
 }
 
+func TestHandlerCreateWithDetails(t *testing.T) {
+	ctrl, mockService, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		CreateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e *models.EventCreate) (uint, []*models.Warning, error) {
+			if e.Title != "Review" || e.Location != "Room 4" || e.URL != "https://example.com/meet" ||
+				e.Metadata["color"] != "blue" {
+				t.Fatalf("unexpected event %+v", e)
+			}
+			return 1, nil, nil
+		})
+
+	body := `{"title": "Review", "description": "Q1 budget", "location": "Room 4", "url": "https://example.com/meet",
+		"metadata": {"color": "blue"}, "date": "2026-03-02T10:00:00Z"}`
+	w := httptest.NewRecorder()
+	h.CreateEvent(w, newRequest(http.MethodPost, "/create_event", strings.NewReader(body)))
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+}
+
+func TestHandlerCreateInvalidDetails(t *testing.T) {
+	ctrl, _, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	for _, body := range []string{
+		`{"date": "2026-03-02T10:00:00Z"}`,
+		`{"title": "Review", "url": "javascript:alert(1)", "date": "2026-03-02T10:00:00Z"}`,
+		`{"title": "` + strings.Repeat("a", 256) + `", "date": "2026-03-02T10:00:00Z"}`,
+	} {
+		w := httptest.NewRecorder()
+		h.CreateEvent(w, newRequest(http.MethodPost, "/create_event", strings.NewReader(body)))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("body %s: expected status %d, got %d", body, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
 func TestHandlerCreateInvalidBody(t *testing.T) {
 	ctrl, _, h := setupPostHandler(t)
 	defer ctrl.Finish()
 
-	req := httptest.NewRequest(http.MethodPost, "/create_events", bytes.NewReader([]byte("{invalid json")))
+	req := newRequest(http.MethodPost, "/create_events", bytes.NewReader([]byte("{invalid json")))
 	w := httptest.NewRecorder()
 
 	h.CreateEvent(w, req)
@@ -86,10 +138,10 @@ func TestHandlerDeleteSuccess(t *testing.T) {
 	defer ctrl.Finish()
 
 	eventID := uint(1)
-	reqBody := models.EventDelete{ID: eventID}
+	reqBody := models.EventDelete{ID: eventID, UserID: 1}
 	body, _ := json.Marshal(reqBody)
 
-	req := httptest.NewRequest(http.MethodDelete, "/delete_event", bytes.NewReader(body))
+	req := newRequest(http.MethodDelete, "/delete_event", bytes.NewReader(body))
 
 	rc := chi.NewRouteContext()
 	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
@@ -97,7 +149,7 @@ func TestHandlerDeleteSuccess(t *testing.T) {
 	w := httptest.NewRecorder()
 
 	mockService.EXPECT().
-		DeleteEvent(gomock.Any(), eventID).
+		DeleteEvent(gomock.Any(), &reqBody).
 		Return(uint(eventID), nil)
 
 	h.DeleteEvent(w, req)
@@ -116,17 +168,17 @@ func TestHandlerUpdateSuccess(t *testing.T) {
 	reqBody := models.Event{
 		ID:     eventID,
 		UserID: userID,
-		Event:  "UPDATE",
+		Title:  "UPDATE",
 		Date:   time.Now(),
 	}
 	body, _ := json.Marshal(reqBody)
 
-	req := httptest.NewRequest(http.MethodPut, "/update_event", bytes.NewReader(body))
+	req := newRequest(http.MethodPut, "/update_event", bytes.NewReader(body))
 	w := httptest.NewRecorder()
 
 	mockService.EXPECT().
 		UpdateEvent(gomock.Any(), gomock.Any()).
-		Return(uint(1), nil)
+		Return(uint(1), nil, nil)
 
 	h.UpdateEvent(w, req)
 
@@ -144,17 +196,17 @@ func TestHandlerUpdateNotFound(t *testing.T) {
 	reqBody := models.Event{
 		ID:     eventID,
 		UserID: userID,
-		Event:  "UPDATE",
+		Title:  "UPDATE",
 		Date:   time.Now(),
 	}
 	body, _ := json.Marshal(reqBody)
 
-	req := httptest.NewRequest(http.MethodPut, "/update_event", bytes.NewReader(body))
+	req := newRequest(http.MethodPut, "/update_event", bytes.NewReader(body))
 	w := httptest.NewRecorder()
 
 	mockService.EXPECT().
 		UpdateEvent(gomock.Any(), gomock.Any()).
-		Return(uint(1), eventR.ErrEventNotFound)
+		Return(uint(1), nil, eventR.ErrEventNotFound)
 
 	h.UpdateEvent(w, req)
 
@@ -163,6 +215,24 @@ func TestHandlerUpdateNotFound(t *testing.T) {
 	}
 }
 
+func TestHandlerDeleteForbidden(t *testing.T) {
+	ctrl, mockService, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	req := newRequest(http.MethodDelete, "/delete_event", strings.NewReader(`{"id": 7}`))
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		DeleteEvent(gomock.Any(), &models.EventDelete{ID: 7, UserID: 1}).
+		Return(uint(0), fmt.Errorf("service/DeleteEvent - %w", eventR.ErrForbidden))
+
+	h.DeleteEvent(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
+
 func TestHandlerGetEventsForWeekSuccess(t *testing.T) {
 	ctrl, mockService, h := setupGetHandler(t)
 	defer ctrl.Finish()
@@ -170,15 +240,9 @@ func TestHandlerGetEventsForWeekSuccess(t *testing.T) {
 	userID := 1
 	date := time.Date(2026, 1, 22, 0, 0, 0, 0, time.UTC)
 
-	reqBody := struct {
-		UserID int `json:"user_id"`
-	}{UserID: userID}
-
-	body, _ := json.Marshal(reqBody)
-
 	dateQueryParam := date.Format("2006-01-02T15:04:05Z")
 
-	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/events_for_week?date=%s", dateQueryParam), bytes.NewReader(body))
+	req := newRequest(http.MethodGet, fmt.Sprintf("/events_for_week?date=%s", dateQueryParam), nil)
 	w := httptest.NewRecorder()
 
 	parsedDate, err := time.Parse("2006-01-02T15:04:05Z", dateQueryParam)
@@ -186,17 +250,18 @@ func TestHandlerGetEventsForWeekSuccess(t *testing.T) {
 		t.Fatal("can't parse date:", err)
 	}
 
+	weekStart := time.Date(2026, 1, 19, 0, 0, 0, 0, time.UTC)
 	getData := &models.EventGet{
-		UserID: userID, DateFrom: parsedDate, DateTo: parsedDate.Add(time.Hour * 24 * 7),
+		UserID: userID, DateFrom: weekStart, DateTo: weekStart.AddDate(0, 0, 7),
 	}
 
 	mockEventsRes := []*models.Event{
-		{ID: uint(1), UserID: userID, Event: "I am event", Date: parsedDate},
+		{ID: uint(1), UserID: userID, Title: "I am event", Date: parsedDate},
 	}
 
 	mockService.EXPECT().
-		GetEvents(gomock.Any(), getData).
-		Return(mockEventsRes, nil)
+		GetEventsPage(gomock.Any(), getData).
+		Return(&models.EventPage{Events: mockEventsRes}, nil)
 
 	h.GetEventsForWeek(w, req)
 
@@ -204,3 +269,691 @@ func TestHandlerGetEventsForWeekSuccess(t *testing.T) {
 		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
 	}
 }
+
+func TestHandlerDeleteOccurrenceWithoutRecurrenceID(t *testing.T) {
+	ctrl, _, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	reqBody := models.EventDelete{ID: 1, Scope: models.ScopeThis}
+	body, _ := json.Marshal(reqBody)
+
+	req := newRequest(http.MethodDelete, "/delete_event", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	h.DeleteEvent(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerCreateInvalidRRule(t *testing.T) {
+	ctrl, _, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	reqBody := models.EventCreate{
+		UserID: 1,
+		Title:  "Test Event",
+		Date:   time.Now(),
+		RRule:  "FREQ=SOMETIMES",
+	}
+	body, _ := json.Marshal(reqBody)
+
+	req := newRequest(http.MethodPost, "/create_event", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	h.CreateEvent(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerCreateEndBeforeStart(t *testing.T) {
+	ctrl, _, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	reqBody := models.EventCreate{
+		UserID:  1,
+		Title:   "Test Event",
+		Date:    now,
+		EndDate: now.Add(-time.Hour),
+	}
+	body, _ := json.Marshal(reqBody)
+
+	req := newRequest(http.MethodPost, "/create_event", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	h.CreateEvent(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerGetEventsForDayInTimeZone(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	req := newRequest(http.MethodGet, "/events_for_day?date=2026-03-29T00:00:00Z&tz=Europe/Berlin", nil)
+	w := httptest.NewRecorder()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal("can't load location:", err)
+	}
+
+	dateFrom := time.Date(2026, 3, 29, 0, 0, 0, 0, berlin)
+	getData := &models.EventGet{
+		UserID: 1, DateFrom: dateFrom, DateTo: time.Date(2026, 3, 30, 0, 0, 0, 0, berlin),
+	}
+
+	mockService.EXPECT().
+		GetEventsPage(gomock.Any(), getData).
+		Return(&models.EventPage{Events: []*models.Event{}}, nil)
+
+	h.GetEventsForDay(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if getData.DateTo.Sub(getData.DateFrom) != 23*time.Hour {
+		t.Fatalf("expected a 23 hour day, got %v", getData.DateTo.Sub(getData.DateFrom))
+	}
+}
+
+func TestHandlerGetEventsInvalidTimeZone(t *testing.T) {
+	ctrl, _, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	req := newRequest(http.MethodGet, "/events_for_day?date=2026-03-29T00:00:00Z&tz=Mars/Olympus", nil)
+	w := httptest.NewRecorder()
+
+	h.GetEventsForDay(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerGetEventsForWeekCustomStart(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	req := newRequest(http.MethodGet, "/events_for_week?date=2026-01-22T15:00:00Z&week_start=sunday", nil)
+	w := httptest.NewRecorder()
+
+	weekStart := time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC)
+	getData := &models.EventGet{
+		UserID: 1, DateFrom: weekStart, DateTo: weekStart.AddDate(0, 0, 7),
+	}
+
+	mockService.EXPECT().
+		GetEventsPage(gomock.Any(), getData).
+		Return(&models.EventPage{Events: []*models.Event{}}, nil)
+
+	h.GetEventsForWeek(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandlerGetEventsForMonth(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	req := newRequest(http.MethodGet, "/events_for_month?date=2026-02-14T10:00:00Z", nil)
+	w := httptest.NewRecorder()
+
+	getData := &models.EventGet{
+		UserID:   1,
+		DateFrom: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		DateTo:   time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockService.EXPECT().
+		GetEventsPage(gomock.Any(), getData).
+		Return(&models.EventPage{Events: []*models.Event{}}, nil)
+
+	h.GetEventsForMonth(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandlerGetEventsForRangeInvalid(t *testing.T) {
+	ctrl, _, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	req := newRequest(http.MethodGet,
+		"/events_for_range?from=2026-02-14T10:00:00Z&to=2026-02-01T10:00:00Z", nil)
+	w := httptest.NewRecorder()
+
+	h.GetEventsForRange(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerGetEventsForRangePage(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	req := newRequest(http.MethodGet,
+		"/events_for_range?from=2026-02-01T00:00:00Z&to=2026-03-01T00:00:00Z&limit=1&cursor=abc", nil)
+	w := httptest.NewRecorder()
+
+	getData := &models.EventGet{
+		UserID:   1,
+		DateFrom: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		DateTo:   time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		Limit:    1,
+		Cursor:   "abc",
+	}
+
+	mockService.EXPECT().
+		GetEventsPage(gomock.Any(), getData).
+		Return(&models.EventPage{Events: []*models.Event{{ID: 2, UserID: 1, Title: "Sync"}}, NextCursor: "def"}, nil)
+
+	h.GetEventsForRange(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response models.EventPage
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response.Events) != 1 || response.NextCursor != "def" {
+		t.Fatalf("unexpected response %+v", response)
+	}
+}
+
+func TestHandlerGetEventsInvalidLimit(t *testing.T) {
+	ctrl, _, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	for _, limit := range []string{"abc", "-1", "1000"} {
+		w := httptest.NewRecorder()
+		h.GetEventsForDay(w, newRequest(http.MethodGet, "/events_for_day?date=2026-02-14T10:00:00Z&limit="+limit, nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("limit %q: expected status %d, got %d", limit, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestHandlerGetEventsInvalidCursor(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		GetEventsPage(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("service/GetEventsPage - %w", eventS.ErrInvalidCursor))
+
+	w := httptest.NewRecorder()
+	h.GetEventsForDay(w, newRequest(http.MethodGet, "/events_for_day?date=2026-02-14T10:00:00Z&cursor=garbage", nil))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerGetEventsWithFilters(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	req := newRequest(http.MethodGet, "/events?from=2026-02-01T00:00:00Z&to=2026-03-01T00:00:00Z"+
+		"&user_ids=2,3&calendar_ids=7&tags=work&q=budget&sort=-date&limit=20&fields=title,date", nil)
+	w := httptest.NewRecorder()
+
+	date := time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC)
+	getData := &models.EventGet{
+		UserID:      1,
+		DateFrom:    time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		DateTo:      time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		Tags:        []string{"work"},
+		OwnerIDs:    []int{2, 3},
+		CalendarIDs: []uint{7},
+		Query:       "budget",
+		Sort:        models.SortDateDesc,
+		Limit:       20,
+	}
+
+	mockService.EXPECT().
+		GetEventsPage(gomock.Any(), getData).
+		Return(&models.EventPage{Events: []*models.Event{
+			{ID: 4, UserID: 2, CalendarID: 7, Title: "Budget review", Location: "Room 4", Date: date, EndDate: date},
+		}}, nil)
+
+	h.GetEvents(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response map[string][]map[string]any
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	event := response["result"][0]
+	if len(event) != 3 || event["id"] != float64(4) || event["title"] != "Budget review" || event["date"] == nil {
+		t.Fatalf("unexpected event %v", event)
+	}
+}
+
+func TestHandlerGetEventsInvalidFilters(t *testing.T) {
+	ctrl, _, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	for _, query := range []string{"calendar_ids=x", "user_ids=0", "sort=title", "fields=title,secret"} {
+		w := httptest.NewRecorder()
+		h.GetEvents(w, newRequest(http.MethodGet,
+			"/events?from=2026-02-01T00:00:00Z&to=2026-03-01T00:00:00Z&"+query, nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected status %d, got %d", query, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestHandlerGetEventsForDayDateFormats(t *testing.T) {
+	moscow, _ := time.LoadLocation("Europe/Moscow")
+	plusThree := time.FixedZone("", 3*60*60)
+
+	for _, tc := range []struct {
+		query string
+		from  time.Time
+	}{
+		{"date=2026-01-22", time.Date(2026, 1, 22, 0, 0, 0, 0, time.UTC)},
+		{"date=2026-01-22&tz=Europe/Moscow", time.Date(2026, 1, 22, 0, 0, 0, 0, moscow)},
+		{"date=2026-01-22T01:30:00%2B03:00", time.Date(2026, 1, 22, 0, 0, 0, 0, plusThree)},
+		{"date=2026-01-22T01:30:00+03:00", time.Date(2026, 1, 22, 0, 0, 0, 0, plusThree)},
+		{"date=2026-01-22T01:30:00.5%2B03:00&tz=UTC", time.Date(2026, 1, 21, 0, 0, 0, 0, time.UTC)},
+		{"date=2026-01-22T22:30:00Z&tz=Europe/Moscow", time.Date(2026, 1, 23, 0, 0, 0, 0, moscow)},
+		{"date=2026-01-22T22:30:00%2B00:00&tz=Europe/Moscow", time.Date(2026, 1, 23, 0, 0, 0, 0, moscow)},
+	} {
+		ctrl, mockService, h := setupGetHandler(t)
+
+		mockService.EXPECT().
+			GetEventsPage(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, eventGet *models.EventGet) (*models.EventPage, error) {
+				if !eventGet.DateFrom.Equal(tc.from) || !eventGet.DateTo.Equal(tc.from.AddDate(0, 0, 1)) {
+					t.Fatalf("%s: unexpected window [%s, %s)", tc.query, eventGet.DateFrom, eventGet.DateTo)
+				}
+				return &models.EventPage{Events: []*models.Event{}}, nil
+			})
+
+		w := httptest.NewRecorder()
+		h.GetEventsForDay(w, newRequest(http.MethodGet, "/events_for_day?"+tc.query, nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: expected status %d, got %d", tc.query, http.StatusOK, w.Code)
+		}
+		ctrl.Finish()
+	}
+}
+
+func TestHandlerGetEventsForDayInvalidDate(t *testing.T) {
+	ctrl, _, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	for _, date := range []string{"22.01.2026", "2026-01-22T25:00:00Z", "2026-13-01"} {
+		w := httptest.NewRecorder()
+		h.GetEventsForDay(w, newRequest(http.MethodGet, "/events_for_day?date="+date, nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected status %d, got %d", date, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestHandlerGetArchivedEvents(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	req := newRequest(http.MethodGet,
+		"/archived_events?from=2024-01-01T00:00:00Z&to=2025-01-01T00:00:00Z", nil)
+	w := httptest.NewRecorder()
+
+	getData := &models.EventGet{
+		UserID:   1,
+		DateFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		DateTo:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockService.EXPECT().
+		GetArchivedEvents(gomock.Any(), getData).
+		Return([]*models.Event{{ID: 1, UserID: 1, Title: "Old meeting"}}, nil)
+
+	h.GetArchivedEvents(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandlerExportEvents(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	req := newRequest(http.MethodGet,
+		"/export_events?from=2026-01-01T00:00:00Z&to=2026-02-01T00:00:00Z", nil)
+	w := httptest.NewRecorder()
+
+	date := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	getData := &models.EventGet{
+		UserID:   1,
+		DateFrom: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		DateTo:   time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockService.EXPECT().
+		GetEvents(gomock.Any(), getData).
+		Return([]*models.Event{{ID: 3, UserID: 1, Title: "Standup", Date: date, EndDate: date}}, nil)
+
+	h.ExportEvents(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "text/calendar; charset=utf-8" {
+		t.Fatalf("unexpected content type %q", ct)
+	}
+	if !strings.Contains(w.Body.String(), "UID:event-3@calendar-service\r\n") {
+		t.Fatalf("unexpected body %q", w.Body.String())
+	}
+}
+
+func TestHandlerImportEvents(t *testing.T) {
+	ctrl, mockService, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	body := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n" +
+		"BEGIN:VEVENT\r\nUID:a@example.com\r\nDTSTART:20260105T090000Z\r\nSUMMARY:Standup\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nUID:b@example.com\r\nSUMMARY:No start\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	req := newRequest(http.MethodPost, "/import_events", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/calendar")
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		ImportEvents(gomock.Any(), gomock.Len(1)).
+		DoAndReturn(func(_ context.Context, events []*models.EventCreate) ([]*models.ImportResult, error) {
+			if events[0].UserID != 1 || events[0].UID != "a@example.com" {
+				t.Fatalf("unexpected event %+v", events[0])
+			}
+			return []*models.ImportResult{{UID: "a@example.com", ID: 5, Status: models.ImportCreated}}, nil
+		})
+
+	h.ImportEvents(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response map[string][]models.ImportResult
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	results := response["result"]
+	if len(results) != 2 || results[0].Status != models.ImportCreated || results[1].Status != models.ImportSkipped ||
+		results[1].Error == "" {
+		t.Fatalf("unexpected results %+v", results)
+	}
+}
+
+func TestHandlerImportEventsReadOnly(t *testing.T) {
+	ctrl, mockService, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	body := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n" +
+		"BEGIN:VEVENT\r\nUID:a@example.com\r\nDTSTART:20260105T090000Z\r\nSUMMARY:Standup\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	req := newRequest(http.MethodPost, "/import_events", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/calendar")
+	w := httptest.NewRecorder()
+
+	mockService.EXPECT().
+		ImportEvents(gomock.Any(), gomock.Len(1)).
+		Return(nil, fmt.Errorf("service/ImportEvents - %w", eventR.ErrForbidden))
+
+	h.ImportEvents(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
+
+func TestHandlerImportEventsUnauthorized(t *testing.T) {
+	ctrl, _, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodPost, "/import_events", strings.NewReader("BEGIN:VCALENDAR\r\n"))
+	w := httptest.NewRecorder()
+
+	h.ImportEvents(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
+
+func TestHandlerCreateConflictRejected(t *testing.T) {
+	ctrl, mockService, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	conflict := &models.Event{ID: 3, UserID: 1, Title: "Standup"}
+	mockService.EXPECT().
+		CreateEvent(gomock.Any(), gomock.Any()).
+		Return(uint(0), nil, fmt.Errorf("service/CreateEvent - %w",
+			&eventS.ConflictError{Events: []*models.Event{conflict}}))
+
+	body := `{"title": "Review", "date": "2026-03-02T10:00:00Z", "end_date": "2026-03-02T11:00:00Z",
+		"on_conflict": "reject"}`
+	w := httptest.NewRecorder()
+	h.CreateEvent(w, newRequest(http.MethodPost, "/create_event", strings.NewReader(body)))
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+
+	var response struct {
+		Conflicts []*models.Event `json:"conflicts"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response.Conflicts) != 1 || response.Conflicts[0].ID != 3 {
+		t.Fatalf("unexpected conflicts %v", response.Conflicts)
+	}
+}
+
+func TestHandlerUpdateConflictWarning(t *testing.T) {
+	ctrl, mockService, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		UpdateEvent(gomock.Any(), gomock.Any()).
+		Return(uint(1), []*models.Warning{{Code: models.WarningConflict, Message: "overlaps event 3",
+			Event: &models.Event{ID: 3, UserID: 1, Title: "Standup"}}}, nil)
+
+	body := `{"id": 1, "user_id": 1, "title": "Review", "date": "2026-03-02T10:00:00Z"}`
+	w := httptest.NewRecorder()
+	h.UpdateEvent(w, newRequest(http.MethodPut, "/update_event", strings.NewReader(body)))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response struct {
+		Result   uint              `json:"result"`
+		Warnings []*models.Warning `json:"warnings"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response.Warnings) != 1 || response.Warnings[0].Code != models.WarningConflict {
+		t.Fatalf("unexpected warnings %v", response.Warnings)
+	}
+}
+
+func TestHandlerCreateInvalidConflictOption(t *testing.T) {
+	ctrl, _, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	body := `{"title": "Review", "date": "2026-03-02T10:00:00Z", "on_conflict": "ignore"}`
+	w := httptest.NewRecorder()
+	h.CreateEvent(w, newRequest(http.MethodPost, "/create_event", strings.NewReader(body)))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerFreeBusy(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	mockService.EXPECT().
+		FreeBusy(gomock.Any(),
+			&models.FreeBusyQuery{UserID: 1, UserIDs: []int{2, 3}, DateFrom: from, DateTo: from.AddDate(0, 0, 1)}).
+		Return([]*models.FreeBusy{{UserID: 2, Busy: []models.BusyInterval{}}, {UserID: 3, Busy: []models.BusyInterval{}}},
+			nil)
+
+	req := newRequest(http.MethodGet, "/freebusy?user_ids=2,3&from=2026-03-02T00:00:00Z&to=2026-03-03T00:00:00Z", nil)
+	w := httptest.NewRecorder()
+	h.FreeBusy(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandlerFreeBusyNotCalendarPeer(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		FreeBusy(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("service/FreeBusy - %w", eventS.ErrNotCalendarPeer))
+
+	req := newRequest(http.MethodGet, "/freebusy?user_ids=9&from=2026-03-02T00:00:00Z&to=2026-03-03T00:00:00Z", nil)
+	w := httptest.NewRecorder()
+	h.FreeBusy(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
+
+func TestHandlerFreeBusyInvalidUserIDs(t *testing.T) {
+	ctrl, _, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	for _, userIDs := range []string{"2,x", "2,2", "0"} {
+		req := newRequest(http.MethodGet,
+			"/freebusy?user_ids="+userIDs+"&from=2026-03-02T00:00:00Z&to=2026-03-03T00:00:00Z", nil)
+		w := httptest.NewRecorder()
+		h.FreeBusy(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("user_ids %q: expected status %d, got %d", userIDs, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestHandlerSearchEvents(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	mockService.EXPECT().
+		SearchEvents(gomock.Any(), &models.EventSearch{UserID: 1, Query: `"team sync"`, DateFrom: from, Limit: 10}).
+		Return([]*models.Event{{ID: 1, UserID: 1, Title: "Team sync"}}, nil)
+
+	req := newRequest(http.MethodGet, "/search?q=%22team+sync%22&from=2026-03-02T00:00:00Z&limit=10", nil)
+	w := httptest.NewRecorder()
+	h.SearchEvents(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandlerSearchEventsNoWords(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		SearchEvents(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("service/SearchEvents - %w", eventS.ErrEmptySearchQuery))
+
+	req := newRequest(http.MethodGet, "/search?q=%2A%2A", nil)
+	w := httptest.NewRecorder()
+	h.SearchEvents(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerSearchEventsMissingQuery(t *testing.T) {
+	ctrl, _, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	req := newRequest(http.MethodGet, "/search?from=2026-03-02T00:00:00Z", nil)
+	w := httptest.NewRecorder()
+	h.SearchEvents(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerFindSlotsInvalidWorkingHours(t *testing.T) {
+	ctrl, mockService, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		FindSlots(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("service/FindSlots - %w", eventS.ErrInvalidWorkingHours))
+
+	body := `{"user_ids": [2, 3], "duration": 30, "from": "2026-03-02T00:00:00Z", "to": "2026-03-07T00:00:00Z",
+		"working_hours": {"start": "18:00", "end": "09:00"}}`
+	w := httptest.NewRecorder()
+	h.FindSlots(w, newRequest(http.MethodPost, "/find_slots", strings.NewReader(body)))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerFindSlotsValidation(t *testing.T) {
+	ctrl, _, h := setupPostHandler(t)
+	defer ctrl.Finish()
+
+	for _, body := range []string{
+		`{"user_ids": [2], "from": "2026-03-02T00:00:00Z", "to": "2026-03-07T00:00:00Z"}`,
+		`{"user_ids": [2], "duration": 30, "from": "2026-03-07T00:00:00Z", "to": "2026-03-02T00:00:00Z"}`,
+		`{"user_ids": [2], "duration": 30, "from": "2026-03-02T00:00:00Z", "to": "2026-03-07T00:00:00Z",
+			"rank": "latest"}`,
+		`{"user_ids": [2], "duration": 30, "from": "2026-03-02T00:00:00Z", "to": "2026-03-07T00:00:00Z",
+			"working_hours": {"start": "9am", "end": "18:00"}}`,
+	} {
+		w := httptest.NewRecorder()
+		h.FindSlots(w, newRequest(http.MethodPost, "/find_slots", strings.NewReader(body)))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("body %s: expected status %d, got %d", body, http.StatusBadRequest, w.Code)
+		}
+	}
+}
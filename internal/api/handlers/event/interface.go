@@ -8,7 +8,23 @@ import (
 //go:generate mockgen -source=interface.go -destination=../../../mocks/mock_handlers.go -package=mocks
 type eventService interface {
 	GetEvents(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error)
-	CreateEvent(ctx context.Context, event *models.EventCreate) (uint, error)
-	UpdateEvent(ctx context.Context, event *models.Event) (uint, error)
-	DeleteEvent(ctx context.Context, ID uint) (uint, error)
+	GetEventsPage(ctx context.Context, eventGet *models.EventGet) (*models.EventPage, error)
+	GetArchivedEvents(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error)
+	SearchEvents(ctx context.Context, search *models.EventSearch) ([]*models.Event, error)
+	FreeBusy(ctx context.Context, query *models.FreeBusyQuery) ([]*models.FreeBusy, error)
+	FindSlots(ctx context.Context, query *models.SlotQuery) ([]*models.Slot, error)
+	CreateEvent(ctx context.Context, event *models.EventCreate) (uint, []*models.Warning, error)
+	ImportEvents(ctx context.Context, events []*models.EventCreate) ([]*models.ImportResult, error)
+	UpdateEvent(ctx context.Context, event *models.EventUpdate) (uint, []*models.Warning, error)
+	DeleteEvent(ctx context.Context, eventDelete *models.EventDelete) (uint, error)
+	InviteAttendees(ctx context.Context, invite *models.EventInvite) ([]*models.Attendee, error)
+	GetAttendees(ctx context.Context, userID int, eventID uint) ([]*models.Attendee, error)
+	RespondToEvent(ctx context.Context, rsvp *models.EventRSVP) error
+	RemoveAttendee(ctx context.Context, userID int, eventID uint, attendeeID int) error
+	CreateTag(ctx context.Context, tag *models.Tag) (uint, error)
+	GetTags(ctx context.Context, userID int) ([]*models.Tag, error)
+	UpdateTag(ctx context.Context, tag *models.Tag) error
+	DeleteTag(ctx context.Context, userID int, ID uint) error
+	SetEventTags(ctx context.Context, eventTags *models.EventTags) error
+	TagSummary(ctx context.Context, eventGet *models.EventGet) ([]*models.TagCount, error)
 }
@@ -3,16 +3,23 @@ package event
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"go.uber.org/zap"
 
+	"github.com/avraam311/calendar-service/internal/middlewares"
 	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/ical"
 	"github.com/avraam311/calendar-service/internal/pkg/validator"
 	eventR "github.com/avraam311/calendar-service/internal/repository/event"
+	eventS "github.com/avraam311/calendar-service/internal/service/event"
 )
 
+const maxImportSize = 10 << 20
+
 type PostHandler struct {
 	logger       *zap.Logger
 	validator    *validator.GoValidator
@@ -34,13 +41,19 @@ func (h *PostHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
 	var event *models.EventCreate
 	err := json.NewDecoder(r.Body).Decode(&event)
-	if err != nil {
+	if err != nil || event == nil {
 		h.logger.Warn("failed to decode JSON", zap.Error(err))
 		h.handleError(w, http.StatusBadRequest, "invalid json")
 		return
 	}
+	event.UserID = userID
 
 	err = h.validator.Validate(event)
 	if err != nil {
@@ -49,8 +62,25 @@ func (h *PostHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ID, err := h.eventService.CreateEvent(r.Context(), event)
+	ID, warnings, err := h.eventService.CreateEvent(r.Context(), event)
 	if err != nil {
+		if h.handleConflict(w, err) {
+			return
+		}
+
+		if errors.Is(err, eventR.ErrCalendarNotFound) {
+			h.logger.Warn("calendar not found", zap.Uint("calendar_id", event.CalendarID))
+			h.handleError(w, http.StatusNotFound, "calendar not found")
+			return
+		}
+
+		if errors.Is(err, eventR.ErrForbidden) {
+			h.logger.Warn("calendar is read-only for the user", zap.Uint("calendar_id", event.CalendarID),
+				zap.Int("user_id", userID))
+			h.handleError(w, http.StatusForbidden, "forbidden")
+			return
+		}
+
 		h.logger.Error("failed to create event", zap.Error(err))
 		h.handleError(w, http.StatusInternalServerError, "internal error")
 		return
@@ -58,9 +88,12 @@ func (h *PostHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 
 	h.logger.Info("event created", zap.Any("event", event))
 
-	response := map[string]uint{
+	response := map[string]any{
 		"result": ID,
 	}
+	if len(warnings) > 0 {
+		response["warnings"] = warnings
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
@@ -78,13 +111,19 @@ func (h *PostHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var event *models.Event
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	var event *models.EventUpdate
 	err := json.NewDecoder(r.Body).Decode(&event)
-	if err != nil {
+	if err != nil || event == nil {
 		h.logger.Warn("failed to decode JSON", zap.Error(err))
 		h.handleError(w, http.StatusBadRequest, "invalid json")
 		return
 	}
+	event.UserID = userID
 
 	err = h.validator.Validate(event)
 	if err != nil {
@@ -93,14 +132,31 @@ func (h *PostHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ID, err := h.eventService.UpdateEvent(r.Context(), event)
+	ID, warnings, err := h.eventService.UpdateEvent(r.Context(), event)
 	if err != nil {
+		if h.handleConflict(w, err) {
+			return
+		}
+
 		if errors.Is(err, eventR.ErrEventNotFound) {
 			h.logger.Warn("event not found", zap.String("ID", strconv.FormatUint(uint64(event.ID), 10)))
 			h.handleError(w, http.StatusNotFound, "event not found")
 			return
 		}
 
+		if errors.Is(err, eventR.ErrForbidden) {
+			h.logger.Warn("event belongs to another user", zap.String("ID", strconv.FormatUint(uint64(event.ID), 10)),
+				zap.Int("user_id", userID))
+			h.handleError(w, http.StatusForbidden, "forbidden")
+			return
+		}
+
+		if errors.Is(err, eventS.ErrRecurrenceIDRequired) {
+			h.logger.Warn("missing recurrence id", zap.String("scope", event.Scope))
+			h.handleError(w, http.StatusBadRequest, "recurrence_id is required for this scope")
+			return
+		}
+
 		h.logger.Error("failed to update event", zap.Error(err))
 		h.handleError(w, http.StatusInternalServerError, "internal error")
 		return
@@ -108,9 +164,12 @@ func (h *PostHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 
 	h.logger.Info("event updated", zap.Any("event", event))
 
-	response := map[string]uint{
+	response := map[string]any{
 		"result": ID,
 	}
+	if len(warnings) > 0 {
+		response["warnings"] = warnings
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
@@ -128,6 +187,11 @@ func (h *PostHandler) DeleteEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
 	var eventID models.EventDelete
 	err := json.NewDecoder(r.Body).Decode(&eventID)
 	if err != nil {
@@ -135,6 +199,7 @@ func (h *PostHandler) DeleteEvent(w http.ResponseWriter, r *http.Request) {
 		h.handleError(w, http.StatusBadRequest, "invalid json")
 		return
 	}
+	eventID.UserID = userID
 
 	err = h.validator.Validate(eventID)
 	if err != nil {
@@ -143,14 +208,27 @@ func (h *PostHandler) DeleteEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ID, err := h.eventService.DeleteEvent(r.Context(), eventID.ID)
+	ID, err := h.eventService.DeleteEvent(r.Context(), &eventID)
 	if err != nil {
 		if errors.Is(err, eventR.ErrEventNotFound) {
-			h.logger.Warn("event not found", zap.String("ID", strconv.FormatUint(uint64(ID), 10)))
+			h.logger.Warn("event not found", zap.String("ID", strconv.FormatUint(uint64(eventID.ID), 10)))
 			h.handleError(w, http.StatusNotFound, "event not found")
 			return
 		}
 
+		if errors.Is(err, eventR.ErrForbidden) {
+			h.logger.Warn("event belongs to another user", zap.String("ID", strconv.FormatUint(uint64(eventID.ID), 10)),
+				zap.Int("user_id", userID))
+			h.handleError(w, http.StatusForbidden, "forbidden")
+			return
+		}
+
+		if errors.Is(err, eventS.ErrRecurrenceIDRequired) {
+			h.logger.Warn("missing recurrence id", zap.String("scope", eventID.Scope))
+			h.handleError(w, http.StatusBadRequest, "recurrence_id is required for this scope")
+			return
+		}
+
 		h.logger.Error("failed to delete event", zap.Error(err))
 		h.handleError(w, http.StatusInternalServerError, "internal error")
 		return
@@ -171,6 +249,189 @@ func (h *PostHandler) DeleteEvent(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ImportEvents imports an iCalendar file sent either as the request body or as
+// the "file" field of a multipart form, and reports the outcome per VEVENT.
+func (h *PostHandler) ImportEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.logger.Warn("not allowed methods")
+		h.handleError(w, http.StatusBadRequest, "only method POST allowed")
+		return
+	}
+
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+
+	var body io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			h.logger.Warn("failed to read uploaded file", zap.Error(err))
+			h.handleError(w, http.StatusBadRequest, "missing file")
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	items, err := ical.Decode(body)
+	if err != nil {
+		h.logger.Warn("failed to decode calendar", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "invalid calendar")
+		return
+	}
+
+	results := make([]*models.ImportResult, len(items))
+	var (
+		events  []*models.EventCreate
+		indexes []int
+	)
+	for i, item := range items {
+		if item.Err == nil {
+			item.Event.UserID = userID
+			item.Err = h.validator.Validate(item.Event)
+		}
+		if item.Err != nil {
+			results[i] = &models.ImportResult{UID: item.UID, Status: models.ImportSkipped, Error: item.Err.Error()}
+			continue
+		}
+
+		events = append(events, item.Event)
+		indexes = append(indexes, i)
+	}
+
+	imported, err := h.eventService.ImportEvents(r.Context(), events)
+	if err != nil {
+		if errors.Is(err, eventR.ErrForbidden) {
+			h.logger.Warn("imported event is read-only for the user", zap.Int("user_id", userID))
+			h.handleError(w, http.StatusForbidden, "forbidden")
+			return
+		}
+
+		h.logger.Error("failed to import events", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+	for i, result := range imported {
+		results[indexes[i]] = result
+	}
+
+	h.logger.Info("events imported", zap.Int("user_id", userID), zap.Int("count", len(results)))
+
+	response := map[string][]*models.ImportResult{
+		"result": results,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		h.logger.Error("failed to encode error response", zap.Error(err))
+		http.Error(w, "error response encoding error", http.StatusInternalServerError)
+	}
+}
+
+// FindSlots suggests meeting slots where the participants are free.
+func (h *PostHandler) FindSlots(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	var query *models.SlotQuery
+	err := json.NewDecoder(r.Body).Decode(&query)
+	if err != nil || query == nil {
+		h.logger.Warn("failed to decode JSON", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+
+	err = h.validator.Validate(query)
+	if err != nil {
+		h.logger.Warn("validation error", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "validation error")
+		return
+	}
+
+	query.UserID = userID
+	slots, err := h.eventService.FindSlots(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, eventS.ErrNotCalendarPeer) {
+			h.logger.Warn("slots for a user outside shared calendars", zap.Ints("user_ids", query.UserIDs))
+			h.handleError(w, http.StatusForbidden, "user_ids must share a calendar with you")
+			return
+		}
+
+		if errors.Is(err, eventS.ErrSlotWindowTooLong) {
+			h.logger.Warn("slot search window too long", zap.Time("from", query.DateFrom), zap.Time("to", query.DateTo))
+			h.handleError(w, http.StatusBadRequest, "search window must not exceed 31 days")
+			return
+		}
+
+		if errors.Is(err, eventS.ErrInvalidWorkingHours) {
+			h.logger.Warn("invalid working hours", zap.Any("working_hours", query.WorkingHours))
+			h.handleError(w, http.StatusBadRequest, "working hours must end after they start")
+			return
+		}
+
+		h.logger.Error("failed to find slots", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	response := map[string][]*models.Slot{
+		"result": slots,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		h.logger.Error("failed to encode error response", zap.Error(err))
+		http.Error(w, "error response encoding error", http.StatusInternalServerError)
+	}
+}
+
+// handleConflict answers 409 with the conflicting events if the event was
+// rejected for overlapping them.
+func (h *PostHandler) handleConflict(w http.ResponseWriter, err error) bool {
+	var conflictErr *eventS.ConflictError
+	if !errors.As(err, &conflictErr) {
+		return false
+	}
+
+	h.logger.Warn("event conflicts with existing events", zap.Int("conflicts", len(conflictErr.Events)))
+
+	response := map[string]any{
+		"error":     conflictErr.Error(),
+		"conflicts": conflictErr.Events,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusConflict)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		h.logger.Error("failed to encode error response", zap.Error(err))
+		http.Error(w, "error response encoding error", http.StatusInternalServerError)
+	}
+
+	return true
+}
+
+func (h *PostHandler) userID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	userID, ok := middlewares.UserID(r.Context())
+	if !ok {
+		h.logger.Warn("unauthenticated request")
+		h.handleError(w, http.StatusUnauthorized, "unauthorized")
+		return 0, false
+	}
+
+	return userID, true
+}
+
 func (h *PostHandler) handleError(w http.ResponseWriter, code int, msg string) {
 	errorResponse := map[string]string{
 		"error": msg,
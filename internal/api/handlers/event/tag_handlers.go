@@ -0,0 +1,250 @@
+package event
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/middlewares"
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	eventR "github.com/avraam311/calendar-service/internal/repository/event"
+)
+
+type TagHandler struct {
+	logger       *zap.Logger
+	validator    *validator.GoValidator
+	eventService eventService
+}
+
+func NewTagHandler(l *zap.Logger, v *validator.GoValidator, s eventService) *TagHandler {
+	return &TagHandler{
+		logger:       l,
+		validator:    v,
+		eventService: s,
+	}
+}
+
+func (h *TagHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	tag, ok := h.decodeTag(w, r, userID)
+	if !ok {
+		return
+	}
+
+	ID, err := h.eventService.CreateTag(r.Context(), tag)
+	if err != nil {
+		h.respondError(w, err, "failed to create tag")
+		return
+	}
+	tag.ID = ID
+
+	h.logger.Info("tag created", zap.Int("user_id", userID), zap.Uint("tag_id", ID))
+
+	h.writeResult(w, http.StatusCreated, tag)
+}
+
+func (h *TagHandler) GetTags(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	tags, err := h.eventService.GetTags(r.Context(), userID)
+	if err != nil {
+		h.respondError(w, err, "failed to get tags")
+		return
+	}
+
+	h.writeResult(w, http.StatusOK, tags)
+}
+
+// UpdateTag renames and recolours a tag; the events keep it.
+func (h *TagHandler) UpdateTag(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	ID, ok := h.pathID(w, r, "id", "invalid tag id")
+	if !ok {
+		return
+	}
+
+	tag, ok := h.decodeTag(w, r, userID)
+	if !ok {
+		return
+	}
+	tag.ID = ID
+
+	err := h.eventService.UpdateTag(r.Context(), tag)
+	if err != nil {
+		h.respondError(w, err, "failed to update tag")
+		return
+	}
+
+	h.logger.Info("tag updated", zap.Int("user_id", userID), zap.Uint("tag_id", ID))
+
+	h.writeResult(w, http.StatusOK, tag)
+}
+
+// DeleteTag deletes a tag and takes it off the events.
+func (h *TagHandler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	ID, ok := h.pathID(w, r, "id", "invalid tag id")
+	if !ok {
+		return
+	}
+
+	err := h.eventService.DeleteTag(r.Context(), userID, ID)
+	if err != nil {
+		h.respondError(w, err, "failed to delete tag")
+		return
+	}
+
+	h.logger.Info("tag deleted", zap.Int("user_id", userID), zap.Uint("tag_id", ID))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetEventTags replaces the caller's tags on the event with the ones listed in
+// "tag_ids"; an empty list takes them all off.
+func (h *TagHandler) SetEventTags(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	eventID, ok := h.pathID(w, r, "id", "invalid event id")
+	if !ok {
+		return
+	}
+
+	var eventTags *models.EventTags
+	err := json.NewDecoder(r.Body).Decode(&eventTags)
+	if err != nil || eventTags == nil {
+		h.logger.Warn("failed to decode JSON", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	eventTags.EventID = eventID
+	eventTags.UserID = userID
+
+	err = h.validator.Validate(eventTags)
+	if err != nil {
+		h.logger.Warn("validation error", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "validation error")
+		return
+	}
+
+	err = h.eventService.SetEventTags(r.Context(), eventTags)
+	if err != nil {
+		h.respondError(w, err, "failed to set event tags")
+		return
+	}
+
+	h.logger.Info("event tags set", zap.Uint("event_id", eventID), zap.Uints("tag_ids", eventTags.TagIDs))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *TagHandler) decodeTag(w http.ResponseWriter, r *http.Request, userID int) (*models.Tag, bool) {
+	var tag *models.Tag
+	err := json.NewDecoder(r.Body).Decode(&tag)
+	if err != nil || tag == nil {
+		h.logger.Warn("failed to decode JSON", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "invalid json")
+		return nil, false
+	}
+	tag.UserID = userID
+
+	err = h.validator.Validate(tag)
+	if err != nil {
+		h.logger.Warn("validation error", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "validation error")
+		return nil, false
+	}
+
+	return tag, true
+}
+
+func (h *TagHandler) userID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	userID, ok := middlewares.UserID(r.Context())
+	if !ok {
+		h.logger.Warn("unauthenticated request")
+		h.handleError(w, http.StatusUnauthorized, "unauthorized")
+		return 0, false
+	}
+
+	return userID, true
+}
+
+func (h *TagHandler) pathID(w http.ResponseWriter, r *http.Request, name, msg string) (uint, bool) {
+	ID, err := strconv.ParseUint(chi.URLParam(r, name), 10, 0)
+	if err != nil || ID == 0 {
+		h.logger.Warn(msg, zap.String(name, chi.URLParam(r, name)))
+		h.handleError(w, http.StatusBadRequest, msg)
+		return 0, false
+	}
+
+	return uint(ID), true
+}
+
+func (h *TagHandler) respondError(w http.ResponseWriter, err error, msg string) {
+	switch {
+	case errors.Is(err, eventR.ErrTagNotFound):
+		h.logger.Warn("tag not found")
+		h.handleError(w, http.StatusNotFound, "tag not found")
+	case errors.Is(err, eventR.ErrTagExists):
+		h.logger.Warn("tag already exists")
+		h.handleError(w, http.StatusConflict, "tag with this name already exists")
+	case errors.Is(err, eventR.ErrEventNotFound):
+		h.logger.Warn("event not found")
+		h.handleError(w, http.StatusNotFound, "event not found")
+	case errors.Is(err, eventR.ErrForbidden):
+		h.logger.Warn("event access denied", zap.Error(err))
+		h.handleError(w, http.StatusForbidden, "forbidden")
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+	}
+}
+
+func (h *TagHandler) writeResult(w http.ResponseWriter, code int, result any) {
+	response := map[string]any{
+		"result": result,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		h.logger.Error("failed to encode response", zap.Error(err))
+		http.Error(w, "response encoding error", http.StatusInternalServerError)
+	}
+}
+
+func (h *TagHandler) handleError(w http.ResponseWriter, code int, msg string) {
+	errorResponse := map[string]string{
+		"error": msg,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(errorResponse)
+	if err != nil {
+		h.logger.Error("failed to encode error response", zap.Error(err))
+		http.Error(w, "error response encoding error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,200 @@
+//go:build unit
+// +build unit
+
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	mockEventS "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	eventR "github.com/avraam311/calendar-service/internal/repository/event"
+)
+
+func setupTagHandler(t *testing.T) (*gomock.Controller, *mockEventS.MockeventService, *TagHandler) {
+	ctrl := gomock.NewController(t)
+	mockService := mockEventS.NewMockeventService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	return ctrl, mockService, NewTagHandler(logger, validator.New(), mockService)
+}
+
+func TestHandlerCreateTag(t *testing.T) {
+	ctrl, mockService, h := setupTagHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		CreateTag(gomock.Any(), &models.Tag{UserID: 1, Name: "Work", Color: "#ff0000"}).
+		Return(uint(3), nil)
+
+	w := httptest.NewRecorder()
+	h.CreateTag(w, newRouteRequest(http.MethodPost, "/tags", `{"name": "Work", "color": "#ff0000"}`, nil))
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+
+	var response map[string]models.Tag
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response["result"].ID != 3 {
+		t.Fatalf("unexpected response %+v", response)
+	}
+}
+
+func TestHandlerCreateTagInvalidColor(t *testing.T) {
+	ctrl, _, h := setupTagHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.CreateTag(w, newRouteRequest(http.MethodPost, "/tags", `{"name": "Work", "color": "red"}`, nil))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerCreateTagExists(t *testing.T) {
+	ctrl, mockService, h := setupTagHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		CreateTag(gomock.Any(), gomock.Any()).
+		Return(uint(0), fmt.Errorf("service/CreateTag - %w", eventR.ErrTagExists))
+
+	w := httptest.NewRecorder()
+	h.CreateTag(w, newRouteRequest(http.MethodPost, "/tags", `{"name": "work"}`, nil))
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+}
+
+func TestHandlerDeleteTagNotFound(t *testing.T) {
+	ctrl, mockService, h := setupTagHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		DeleteTag(gomock.Any(), 1, uint(4)).
+		Return(fmt.Errorf("service/DeleteTag - %w", eventR.ErrTagNotFound))
+
+	w := httptest.NewRecorder()
+	h.DeleteTag(w, newRouteRequest(http.MethodDelete, "/tags/4", "", map[string]string{"id": "4"}))
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandlerSetEventTags(t *testing.T) {
+	ctrl, mockService, h := setupTagHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		SetEventTags(gomock.Any(), &models.EventTags{EventID: 7, UserID: 1, TagIDs: []uint{2, 3}}).
+		Return(nil)
+
+	w := httptest.NewRecorder()
+	h.SetEventTags(w, newRouteRequest(http.MethodPut, "/events/7/tags", `{"tag_ids": [2, 3]}`,
+		map[string]string{"id": "7"}))
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+}
+
+func TestHandlerSetEventTagsDuplicates(t *testing.T) {
+	ctrl, _, h := setupTagHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.SetEventTags(w, newRouteRequest(http.MethodPut, "/events/7/tags", `{"tag_ids": [2, 2]}`,
+		map[string]string{"id": "7"}))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerSetEventTagsForbidden(t *testing.T) {
+	ctrl, mockService, h := setupTagHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		SetEventTags(gomock.Any(), gomock.Any()).
+		Return(fmt.Errorf("service/SetEventTags - %w", eventR.ErrForbidden))
+
+	w := httptest.NewRecorder()
+	h.SetEventTags(w, newRouteRequest(http.MethodPut, "/events/7/tags", `{"tag_ids": [2]}`,
+		map[string]string{"id": "7"}))
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
+
+func TestHandlerGetEventsForDayWithTags(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	day := time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC)
+	getData := &models.EventGet{
+		UserID:      1,
+		DateFrom:    day,
+		DateTo:      day.AddDate(0, 0, 1),
+		Tags:        []string{"work", "travel"},
+		ExcludeTags: []string{"private"},
+	}
+
+	mockService.EXPECT().
+		GetEventsPage(gomock.Any(), getData).
+		Return(&models.EventPage{Events: []*models.Event{}}, nil)
+
+	w := httptest.NewRecorder()
+	h.GetEventsForDay(w, newRequest(http.MethodGet,
+		"/events_for_day?date=2026-02-14T10:00:00Z&tags=work,+travel&exclude_tags=private", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandlerTagSummary(t *testing.T) {
+	ctrl, mockService, h := setupGetHandler(t)
+	defer ctrl.Finish()
+
+	getData := &models.EventGet{
+		UserID:   1,
+		DateFrom: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		DateTo:   time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockService.EXPECT().
+		TagSummary(gomock.Any(), getData).
+		Return([]*models.TagCount{{TagID: 2, Name: "Work", Count: 5}}, nil)
+
+	w := httptest.NewRecorder()
+	h.TagSummary(w, newRequest(http.MethodGet,
+		"/tags/summary?from=2026-02-01T00:00:00Z&to=2026-03-01T00:00:00Z", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response map[string][]models.TagCount
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response["result"]) != 1 || response["result"][0].Count != 5 {
+		t.Fatalf("unexpected response %+v", response)
+	}
+}
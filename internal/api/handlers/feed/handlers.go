@@ -0,0 +1,215 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/middlewares"
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/ical"
+	feedR "github.com/avraam311/calendar-service/internal/repository/feed"
+)
+
+// The feed covers a fixed window around the current day.
+const (
+	feedPastDays   = 365
+	feedFutureDays = 730
+)
+
+type Handler struct {
+	logger       *zap.Logger
+	feedService  feedService
+	eventService eventsGetter
+}
+
+func NewHandler(l *zap.Logger, f feedService, e eventsGetter) *Handler {
+	return &Handler{
+		logger:       l,
+		feedService:  f,
+		eventService: e,
+	}
+}
+
+func (h *Handler) CreateToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.logger.Warn("not allowed methods")
+		h.handleError(w, http.StatusBadRequest, "only method POST allowed")
+		return
+	}
+
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	token, err := h.feedService.CreateToken(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to create feed token", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	h.logger.Info("feed token created", zap.Int("user_id", userID))
+
+	response := map[string]*models.FeedToken{
+		"result": {
+			Token: token,
+			URL:   fmt.Sprintf("/api/feed/%s.ics", token),
+		},
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		h.logger.Error("failed to encode error response", zap.Error(err))
+		http.Error(w, "error response encoding error", http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		h.logger.Warn("not allowed methods")
+		h.handleError(w, http.StatusBadRequest, "only method DELETE allowed")
+		return
+	}
+
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.feedService.RevokeToken(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, feedR.ErrTokenNotFound) {
+			h.logger.Warn("feed token not found", zap.Int("user_id", userID))
+			h.handleError(w, http.StatusNotFound, "feed token not found")
+			return
+		}
+
+		h.logger.Error("failed to revoke feed token", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	h.logger.Info("feed token revoked", zap.Int("user_id", userID))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetFeed serves the user's events as ICS. Clients polling the feed get
+// 304 Not Modified until the user's events change or the window moves on.
+func (h *Handler) GetFeed(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimSuffix(chi.URLParam(r, "token"), ".ics")
+
+	feed, err := h.feedService.GetFeed(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, feedR.ErrTokenNotFound) {
+			h.logger.Warn("unknown feed token")
+			h.handleError(w, http.StatusNotFound, "feed not found")
+			return
+		}
+
+		h.logger.Error("failed to get feed", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	y, m, d := time.Now().UTC().Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	dateFrom := today.AddDate(0, 0, -feedPastDays)
+	dateTo := today.AddDate(0, 0, feedFutureDays)
+
+	lastModified := feed.ModifiedAt.UTC().Truncate(time.Second)
+	if today.After(lastModified) {
+		lastModified = today
+	}
+	etag := fmt.Sprintf(`"%d-%d-%d"`, feed.UserID, feed.ModifiedAt.UnixNano(), today.Unix())
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+	w.Header().Set("Cache-Control", "private, no-cache")
+
+	if notModified(r, etag, lastModified) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	events, err := h.eventService.GetEvents(r.Context(), &models.EventGet{
+		UserID:   feed.UserID,
+		DateFrom: dateFrom,
+		DateTo:   dateTo,
+	})
+	if err != nil {
+		h.logger.Error("failed to get events", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	var buf bytes.Buffer
+	if err = ical.Encode(&buf, events, feed.ModifiedAt); err != nil {
+		h.logger.Error("failed to encode calendar", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err = buf.WriteTo(w); err != nil {
+		h.logger.Error("failed to write calendar", zap.Error(err))
+	}
+}
+
+// notModified evaluates the conditional request headers; If-None-Match takes
+// precedence over If-Modified-Since as in RFC 9110.
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == etag || tag == "*" {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	ims, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+
+	return !lastModified.After(ims)
+}
+
+func (h *Handler) userID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	userID, ok := middlewares.UserID(r.Context())
+	if !ok {
+		h.logger.Warn("unauthenticated request")
+		h.handleError(w, http.StatusUnauthorized, "unauthorized")
+		return 0, false
+	}
+
+	return userID, true
+}
+
+func (h *Handler) handleError(w http.ResponseWriter, code int, msg string) {
+	errorResponse := map[string]string{
+		"error": msg,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(errorResponse)
+	if err != nil {
+		h.logger.Error("failed to encode error response", zap.Error(err))
+		http.Error(w, "error response encoding error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,137 @@
+//go:build unit
+// +build unit
+
+package feed
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/middlewares"
+	"github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	feedR "github.com/avraam311/calendar-service/internal/repository/feed"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocks.MockfeedService, *mocks.MockeventsGetter, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockFeed := mocks.NewMockfeedService(ctrl)
+	mockEvents := mocks.NewMockeventsGetter(ctrl)
+	logger, _ := zap.NewDevelopment()
+	handler := NewHandler(logger, mockFeed, mockEvents)
+	return ctrl, mockFeed, mockEvents, handler
+}
+
+func feedRequest(token string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/feed/%s.ics", token), nil)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("token", token+".ics")
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestHandlerGetFeed(t *testing.T) {
+	ctrl, mockFeed, mockEvents, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	modifiedAt := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	date := time.Date(2026, 1, 6, 9, 0, 0, 0, time.UTC)
+
+	mockFeed.EXPECT().GetFeed(gomock.Any(), "secret").Return(&models.Feed{UserID: 1, ModifiedAt: modifiedAt}, nil).Times(2)
+	mockEvents.EXPECT().
+		GetEvents(gomock.Any(), gomock.Any()).
+		Return([]*models.Event{{ID: 1, UserID: 1, Title: "Standup", Date: date, EndDate: date}}, nil)
+
+	w := httptest.NewRecorder()
+	h.GetFeed(w, feedRequest("secret"))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), "UID:event-1@calendar-service") {
+		t.Fatalf("unexpected body %q", w.Body.String())
+	}
+	etag := w.Header().Get("ETag")
+	if etag == "" || w.Header().Get("Last-Modified") == "" {
+		t.Fatalf("missing validators: %v", w.Header())
+	}
+
+	req := feedRequest("secret")
+	req.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	h.GetFeed(w, req)
+
+	if w.Code != http.StatusNotModified {
+		t.Fatalf("expected status %d, got %d", http.StatusNotModified, w.Code)
+	}
+}
+
+func TestHandlerGetFeedIfModifiedSince(t *testing.T) {
+	ctrl, mockFeed, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockFeed.EXPECT().GetFeed(gomock.Any(), "secret").
+		Return(&models.Feed{UserID: 1, ModifiedAt: time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)}, nil)
+
+	req := feedRequest("secret")
+	req.Header.Set("If-Modified-Since", time.Now().UTC().Add(time.Hour).Format(http.TimeFormat))
+	w := httptest.NewRecorder()
+	h.GetFeed(w, req)
+
+	if w.Code != http.StatusNotModified {
+		t.Fatalf("expected status %d, got %d", http.StatusNotModified, w.Code)
+	}
+}
+
+func TestHandlerGetFeedRevoked(t *testing.T) {
+	ctrl, mockFeed, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockFeed.EXPECT().GetFeed(gomock.Any(), "revoked").Return(nil, feedR.ErrTokenNotFound)
+
+	w := httptest.NewRecorder()
+	h.GetFeed(w, feedRequest("revoked"))
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestHandlerCreateToken(t *testing.T) {
+	ctrl, mockFeed, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockFeed.EXPECT().CreateToken(gomock.Any(), 1).Return("secret", nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/feed_token", nil)
+	req = req.WithContext(middlewares.ContextWithUserID(req.Context(), 1))
+	w := httptest.NewRecorder()
+	h.CreateToken(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), `"url":"/api/feed/secret.ics"`) {
+		t.Fatalf("unexpected body %q", w.Body.String())
+	}
+}
+
+func TestHandlerCreateTokenUnauthorized(t *testing.T) {
+	ctrl, _, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.CreateToken(w, httptest.NewRequest(http.MethodPost, "/feed_token", nil))
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
@@ -0,0 +1,18 @@
+package feed
+
+import (
+	"context"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+//go:generate mockgen -source=interface.go -destination=../../../mocks/mock_feed_handlers.go -package=mocks
+type feedService interface {
+	CreateToken(ctx context.Context, userID int) (string, error)
+	RevokeToken(ctx context.Context, userID int) error
+	GetFeed(ctx context.Context, token string) (*models.Feed, error)
+}
+
+type eventsGetter interface {
+	GetEvents(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error)
+}
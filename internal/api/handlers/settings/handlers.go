@@ -0,0 +1,226 @@
+package settings
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/middlewares"
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	settingsR "github.com/avraam311/calendar-service/internal/repository/settings"
+	settingsS "github.com/avraam311/calendar-service/internal/service/settings"
+)
+
+type Handler struct {
+	logger          *zap.Logger
+	validator       *validator.GoValidator
+	settingsService settingsService
+}
+
+func NewHandler(l *zap.Logger, v *validator.GoValidator, s settingsService) *Handler {
+	return &Handler{
+		logger:          l,
+		validator:       v,
+		settingsService: s,
+	}
+}
+
+func (h *Handler) GetSettings(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	settings, err := h.settingsService.GetSettings(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to get settings", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	h.writeResult(w, http.StatusOK, settings)
+}
+
+// SaveSettings replaces the user's time zone and working hours.
+func (h *Handler) SaveSettings(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	var settings *models.UserSettings
+	err := json.NewDecoder(r.Body).Decode(&settings)
+	if err != nil || settings == nil {
+		h.logger.Warn("failed to decode JSON", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	settings.UserID = userID
+	settings.OutOfOffice = nil
+
+	err = h.validator.Validate(settings)
+	if err != nil {
+		h.logger.Warn("validation error", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "validation error")
+		return
+	}
+
+	err = h.settingsService.SaveSettings(r.Context(), settings)
+	if err != nil {
+		if errors.Is(err, settingsS.ErrInvalidWorkingHours) {
+			h.logger.Warn("invalid working hours", zap.Any("working_hours", settings.WorkingHours))
+			h.handleError(w, http.StatusBadRequest, "working hours must end after they start")
+			return
+		}
+
+		h.logger.Error("failed to save settings", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	h.logger.Info("settings saved", zap.Int("user_id", userID))
+
+	h.writeResult(w, http.StatusOK, settings)
+}
+
+func (h *Handler) DeleteSettings(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.settingsService.DeleteSettings(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to delete settings", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	h.logger.Info("settings reset", zap.Int("user_id", userID))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) CreateOutOfOffice(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	var ooo *models.OutOfOffice
+	err := json.NewDecoder(r.Body).Decode(&ooo)
+	if err != nil || ooo == nil {
+		h.logger.Warn("failed to decode JSON", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "invalid json")
+		return
+	}
+	ooo.UserID = userID
+
+	err = h.validator.Validate(ooo)
+	if err != nil {
+		h.logger.Warn("validation error", zap.Error(err))
+		h.handleError(w, http.StatusBadRequest, "validation error")
+		return
+	}
+
+	ID, err := h.settingsService.CreateOutOfOffice(r.Context(), ooo)
+	if err != nil {
+		h.logger.Error("failed to create out-of-office period", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	h.logger.Info("out-of-office period created", zap.Int("user_id", userID), zap.Uint("id", ID))
+
+	h.writeResult(w, http.StatusCreated, ID)
+}
+
+func (h *Handler) GetOutOfOffice(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	periods, err := h.settingsService.GetOutOfOffice(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to get out-of-office periods", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	h.writeResult(w, http.StatusOK, periods)
+}
+
+func (h *Handler) DeleteOutOfOffice(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	ID, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 0)
+	if err != nil || ID == 0 {
+		h.logger.Warn("invalid out-of-office id", zap.String("id", chi.URLParam(r, "id")))
+		h.handleError(w, http.StatusBadRequest, "invalid out-of-office id")
+		return
+	}
+
+	err = h.settingsService.DeleteOutOfOffice(r.Context(), userID, uint(ID))
+	if err != nil {
+		if errors.Is(err, settingsR.ErrOutOfOfficeNotFound) {
+			h.logger.Warn("out-of-office period not found", zap.Uint64("id", ID))
+			h.handleError(w, http.StatusNotFound, "out-of-office period not found")
+			return
+		}
+
+		h.logger.Error("failed to delete out-of-office period", zap.Error(err))
+		h.handleError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	h.logger.Info("out-of-office period deleted", zap.Int("user_id", userID), zap.Uint64("id", ID))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) userID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	userID, ok := middlewares.UserID(r.Context())
+	if !ok {
+		h.logger.Warn("unauthenticated request")
+		h.handleError(w, http.StatusUnauthorized, "unauthorized")
+		return 0, false
+	}
+
+	return userID, true
+}
+
+func (h *Handler) writeResult(w http.ResponseWriter, code int, result any) {
+	response := map[string]any{
+		"result": result,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		h.logger.Error("failed to encode response", zap.Error(err))
+		http.Error(w, "response encoding error", http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) handleError(w http.ResponseWriter, code int, msg string) {
+	errorResponse := map[string]string{
+		"error": msg,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(errorResponse)
+	if err != nil {
+		h.logger.Error("failed to encode error response", zap.Error(err))
+		http.Error(w, "error response encoding error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,124 @@
+//go:build unit
+// +build unit
+
+package settings
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/middlewares"
+	"github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/validator"
+	settingsR "github.com/avraam311/calendar-service/internal/repository/settings"
+	settingsS "github.com/avraam311/calendar-service/internal/service/settings"
+)
+
+func setupHandler(t *testing.T) (*gomock.Controller, *mocks.MocksettingsService, *Handler) {
+	ctrl := gomock.NewController(t)
+	mockService := mocks.NewMocksettingsService(ctrl)
+	logger, _ := zap.NewDevelopment()
+	return ctrl, mockService, NewHandler(logger, validator.New(), mockService)
+}
+
+// newRequest builds a request authenticated as user 1 with the given route
+// parameters.
+func newRequest(method, target, body string, params map[string]string) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	rctx := chi.NewRouteContext()
+	for k, v := range params {
+		rctx.URLParams.Add(k, v)
+	}
+	ctx := context.WithValue(middlewares.ContextWithUserID(req.Context(), 1), chi.RouteCtxKey, rctx)
+	return req.WithContext(ctx)
+}
+
+func TestHandlerSaveSettings(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		SaveSettings(gomock.Any(), &models.UserSettings{
+			UserID:       1,
+			TimeZone:     "Europe/Moscow",
+			WorkingHours: []models.WorkingHours{{Start: "09:00", End: "18:00", Days: []string{"monday"}}},
+		}).
+		Return(nil)
+
+	w := httptest.NewRecorder()
+	h.SaveSettings(w, newRequest(http.MethodPut, "/settings",
+		`{"time_zone": "Europe/Moscow", "working_hours": [{"start": "09:00", "end": "18:00", "days": ["monday"]}]}`,
+		nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestHandlerSaveSettingsInvalidTimeZone(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.SaveSettings(w, newRequest(http.MethodPut, "/settings", `{"time_zone": "Mars/Olympus"}`, nil))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerSaveSettingsInvalidWorkingHours(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		SaveSettings(gomock.Any(), gomock.Any()).
+		Return(fmt.Errorf("service/SaveSettings - %w", settingsS.ErrInvalidWorkingHours))
+
+	w := httptest.NewRecorder()
+	h.SaveSettings(w, newRequest(http.MethodPut, "/settings",
+		`{"time_zone": "UTC", "working_hours": [{"start": "18:00", "end": "09:00"}]}`, nil))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerCreateOutOfOfficeEndBeforeStart(t *testing.T) {
+	ctrl, _, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	w := httptest.NewRecorder()
+	h.CreateOutOfOffice(w, newRequest(http.MethodPost, "/settings/out_of_office",
+		`{"start": "2026-03-09T00:00:00Z", "end": "2026-03-02T00:00:00Z"}`, nil))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandlerDeleteOutOfOfficeNotFound(t *testing.T) {
+	ctrl, mockService, h := setupHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		DeleteOutOfOffice(gomock.Any(), 1, uint(3)).
+		Return(fmt.Errorf("service/DeleteOutOfOffice - %w", settingsR.ErrOutOfOfficeNotFound))
+
+	w := httptest.NewRecorder()
+	h.DeleteOutOfOffice(w, newRequest(http.MethodDelete, "/settings/out_of_office/3", "",
+		map[string]string{"id": "3"}))
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,17 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+//go:generate mockgen -source=interface.go -destination=../../../mocks/mock_settings_handlers.go -package=mocks
+type settingsService interface {
+	GetSettings(ctx context.Context, userID int) (*models.UserSettings, error)
+	SaveSettings(ctx context.Context, settings *models.UserSettings) error
+	DeleteSettings(ctx context.Context, userID int) error
+	CreateOutOfOffice(ctx context.Context, ooo *models.OutOfOffice) (uint, error)
+	GetOutOfOffice(ctx context.Context, userID int) ([]*models.OutOfOffice, error)
+	DeleteOutOfOffice(ctx context.Context, userID int, ID uint) error
+}
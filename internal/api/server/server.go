@@ -9,11 +9,23 @@ import (
 	"github.com/go-chi/cors"
 	"go.uber.org/zap"
 
+	"github.com/avraam311/calendar-service/internal/api/handlers/apikey"
+	"github.com/avraam311/calendar-service/internal/api/handlers/caldav"
+	"github.com/avraam311/calendar-service/internal/api/handlers/calendar"
 	"github.com/avraam311/calendar-service/internal/api/handlers/event"
+	"github.com/avraam311/calendar-service/internal/api/handlers/feed"
+	"github.com/avraam311/calendar-service/internal/api/handlers/settings"
 	"github.com/avraam311/calendar-service/internal/middlewares"
 )
 
-func NewRouter(eventPostHandler *event.PostHandler, eventGetHandler *event.GetHandler, logger *zap.Logger) http.Handler {
+func NewRouter(eventPostHandler *event.PostHandler, eventGetHandler *event.GetHandler,
+	attendeeHandler *event.AttendeeHandler, tagHandler *event.TagHandler, feedHandler *feed.Handler,
+	calendarHandler *calendar.Handler, caldavHandler *caldav.Handler, apiKeyHandler *apikey.Handler,
+	settingsHandler *settings.Handler, auth, apiKeyAuth, basicAuth func(http.Handler) http.Handler,
+	logger *zap.Logger) http.Handler {
+	chi.RegisterMethod("PROPFIND")
+	chi.RegisterMethod("REPORT")
+
 	r := chi.NewRouter()
 
 	r.Use(middleware.RequestID)
@@ -24,19 +36,68 @@ func NewRouter(eventPostHandler *event.PostHandler, eventGetHandler *event.GetHa
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"http://*"},
 		AllowedMethods:   []string{"POST", "PUT", "DELETE"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", middlewares.APIKeyHeader},
 		ExposedHeaders:   []string{"Link"},
 		AllowCredentials: false,
 	}))
 	r.Use(middlewares.Logger(logger))
+	r.Use(apiKeyAuth)
+
+	r.Get("/api/feed/{token}", feedHandler.GetFeed)
+	r.Get("/.well-known/caldav", caldavHandler.WellKnown)
 
 	r.Route("/api", func(r chi.Router) {
+		r.Use(auth)
+		r.Use(middlewares.QueryParams(logger))
 		r.Post("/create_event", eventPostHandler.CreateEvent)
+		r.Post("/import_events", eventPostHandler.ImportEvents)
 		r.Put("/update_event", eventPostHandler.UpdateEvent)
 		r.Delete("/delete_event", eventPostHandler.DeleteEvent)
+		r.Get("/events", eventGetHandler.GetEvents)
 		r.Get("/events_for_day", eventGetHandler.GetEventsForDay)
 		r.Get("/events_for_week", eventGetHandler.GetEventsForWeek)
 		r.Get("/events_for_month", eventGetHandler.GetEventsForMonth)
+		r.Get("/events_for_year", eventGetHandler.GetEventsForYear)
+		r.Get("/events_for_range", eventGetHandler.GetEventsForRange)
+		r.Get("/archived_events", eventGetHandler.GetArchivedEvents)
+		r.Get("/export_events", eventGetHandler.ExportEvents)
+		r.Get("/search", eventGetHandler.SearchEvents)
+		r.Get("/freebusy", eventGetHandler.FreeBusy)
+		r.Post("/find_slots", eventPostHandler.FindSlots)
+		r.Get("/events/{id}/attendees", attendeeHandler.GetAttendees)
+		r.Put("/events/{id}/attendees", attendeeHandler.InviteAttendees)
+		r.Delete("/events/{id}/attendees/{user_id}", attendeeHandler.RemoveAttendee)
+		r.Put("/events/{id}/rsvp", attendeeHandler.RespondToEvent)
+		r.Put("/events/{id}/tags", tagHandler.SetEventTags)
+		r.Post("/tags", tagHandler.CreateTag)
+		r.Get("/tags", tagHandler.GetTags)
+		r.Get("/tags/summary", eventGetHandler.TagSummary)
+		r.Put("/tags/{id}", tagHandler.UpdateTag)
+		r.Delete("/tags/{id}", tagHandler.DeleteTag)
+		r.Post("/calendars", calendarHandler.CreateCalendar)
+		r.Get("/calendars", calendarHandler.GetCalendars)
+		r.Get("/calendars/{id}/members", calendarHandler.GetMembers)
+		r.Put("/calendars/{id}/members", calendarHandler.ShareCalendar)
+		r.Delete("/calendars/{id}/members/{user_id}", calendarHandler.RevokeAccess)
+		r.Get("/settings", settingsHandler.GetSettings)
+		r.Put("/settings", settingsHandler.SaveSettings)
+		r.Delete("/settings", settingsHandler.DeleteSettings)
+		r.Post("/settings/out_of_office", settingsHandler.CreateOutOfOffice)
+		r.Get("/settings/out_of_office", settingsHandler.GetOutOfOffice)
+		r.Delete("/settings/out_of_office/{id}", settingsHandler.DeleteOutOfOffice)
+		r.Post("/feed_token", feedHandler.CreateToken)
+		r.Delete("/feed_token", feedHandler.RevokeToken)
+		r.Post("/api_keys", apiKeyHandler.CreateKey)
+		r.Get("/api_keys", apiKeyHandler.GetKeys)
+		r.Post("/api_keys/{id}/rotate", apiKeyHandler.RotateKey)
+		r.Delete("/api_keys/{id}", apiKeyHandler.RevokeKey)
+	})
+
+	r.Group(func(r chi.Router) {
+		r.Use(basicAuth)
+		r.Use(auth)
+		r.Handle(caldav.BasePath, caldavHandler)
+		r.Handle(caldav.BasePath+"/*", caldavHandler)
 	})
 
 	return r
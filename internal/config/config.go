@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -12,6 +13,10 @@ type Config struct {
 	Server   Server   `yaml:"server"`
 	Logger   Logger   `yaml:"logger"`
 	Database Database `yaml:"database"`
+	Calendar Calendar `yaml:"calendar"`
+	Reminder Reminder `yaml:"reminder"`
+	Archive  Archive  `yaml:"archive"`
+	Auth     Auth     `yaml:"auth"`
 }
 
 type Server struct {
@@ -24,6 +29,45 @@ type Logger struct {
 	MdLogFilePath string `yaml:"mdLogFilePath"`
 }
 
+type Calendar struct {
+	FirstWeekday string `yaml:"firstWeekday"`
+}
+
+type Reminder struct {
+	Interval  time.Duration `yaml:"interval"`
+	Notifiers []string      `yaml:"notifiers"`
+	Webhook   Webhook       `yaml:"webhook"`
+	SMTP      SMTP          `yaml:"smtp"`
+}
+
+type Archive struct {
+	Interval time.Duration `yaml:"interval"`
+	MaxAge   time.Duration `yaml:"maxAge"`
+}
+
+type Auth struct {
+	Algorithm     string `yaml:"algorithm"`
+	Secret        string
+	PublicKeyFile string `yaml:"publicKeyFile"`
+	JWKSFile      string `yaml:"jwksFile"`
+	Issuer        string `yaml:"issuer"`
+	Audience      string `yaml:"audience"`
+}
+
+type Webhook struct {
+	URL     string        `yaml:"url"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+type SMTP struct {
+	Host            string `yaml:"host"`
+	Port            string `yaml:"port"`
+	Username        string
+	Password        string
+	From            string `yaml:"from"`
+	RecipientFormat string `yaml:"recipientFormat"`
+}
+
 type Database struct {
 	Host     string
 	Port     string
@@ -48,6 +92,13 @@ func MustLoad() *Config {
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
 	viper.AddConfigPath("./config")
+	viper.SetDefault("calendar.firstWeekday", "monday")
+	viper.SetDefault("reminder.interval", "30s")
+	viper.SetDefault("reminder.notifiers", []string{"log"})
+	viper.SetDefault("reminder.webhook.timeout", "5s")
+	viper.SetDefault("archive.interval", "1h")
+	viper.SetDefault("archive.maxAge", "8760h")
+	viper.SetDefault("auth.algorithm", "HS256")
 	if err := viper.ReadInConfig(); err != nil {
 		log.Fatalf("error reading config: %v", err)
 	}
@@ -62,6 +113,9 @@ func MustLoad() *Config {
 	cfg.Database.User = os.Getenv("DB_USER")
 	cfg.Database.Password = os.Getenv("DB_PASSWORD")
 	cfg.Database.Name = os.Getenv("DB_NAME")
+	cfg.Reminder.SMTP.Username = os.Getenv("SMTP_USERNAME")
+	cfg.Reminder.SMTP.Password = os.Getenv("SMTP_PASSWORD")
+	cfg.Auth.Secret = os.Getenv("JWT_SECRET")
 
 	return &cfg
 }
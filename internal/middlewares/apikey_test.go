@@ -0,0 +1,85 @@
+//go:build unit
+// +build unit
+
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	apiKeyR "github.com/avraam311/calendar-service/internal/repository/apikey"
+)
+
+func serveAPIKey(t *testing.T, a apiKeyAuthenticator, method, key string) (*httptest.ResponseRecorder, int) {
+	t.Helper()
+
+	var userID int
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ = UserID(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(method, "/api/events_for_day", nil)
+	if key != "" {
+		req.Header.Set(APIKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	APIKeyAuth(zap.NewNop(), a)(next).ServeHTTP(w, req)
+
+	return w, userID
+}
+
+func TestAPIKeyAuthScopes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mocks.NewMockapiKeyAuthenticator(ctrl)
+	readOnly := &models.APIKey{ID: 1, UserID: 5, Scopes: []string{models.APIKeyScopeRead}}
+	mockAuth.EXPECT().Authenticate(gomock.Any(), "cal_read").Return(readOnly, nil).Times(2)
+
+	w, userID := serveAPIKey(t, mockAuth, http.MethodGet, "cal_read")
+	if w.Code != http.StatusOK || userID != 5 {
+		t.Fatalf("expected user 5 with status %d, got %d and %d", http.StatusOK, userID, w.Code)
+	}
+
+	w, _ = serveAPIKey(t, mockAuth, http.MethodPost, "cal_read")
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
+
+func TestAPIKeyAuthInvalidKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mocks.NewMockapiKeyAuthenticator(ctrl)
+	mockAuth.EXPECT().Authenticate(gomock.Any(), "cal_revoked").Return(nil, apiKeyR.ErrKeyNotFound)
+	mockAuth.EXPECT().Authenticate(gomock.Any(), "cal_broken").Return(nil, errors.New("db is down"))
+
+	w, _ := serveAPIKey(t, mockAuth, http.MethodGet, "cal_revoked")
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+
+	w, _ = serveAPIKey(t, mockAuth, http.MethodGet, "cal_broken")
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+	}
+}
+
+func TestAPIKeyAuthWithoutKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	w, userID := serveAPIKey(t, mocks.NewMockapiKeyAuthenticator(ctrl), http.MethodGet, "")
+	if w.Code != http.StatusOK || userID != 0 {
+		t.Fatalf("expected the request to pass unauthenticated, got %d and user %d", w.Code, userID)
+	}
+}
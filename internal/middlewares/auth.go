@@ -0,0 +1,215 @@
+package middlewares
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+// minSecretLength is the shortest HS256 secret accepted: RFC 7518 requires a
+// key at least as long as the hash output.
+const minSecretLength = 32
+
+var (
+	ErrNoSigningKey = errors.New("no signing key configured")
+	ErrUnknownKey   = errors.New("unknown signing key")
+)
+
+type userIDKey struct{}
+
+// ContextWithUserID stores the authenticated user in ctx.
+func ContextWithUserID(ctx context.Context, userID int) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserID returns the authenticated user stored by the auth middleware.
+func UserID(ctx context.Context) (int, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(int)
+	return userID, ok
+}
+
+// NewKeyfunc returns the verification keys for the configured algorithm:
+// the shared secret of at least 32 bytes for HS256, or for RS256 a PEM public key or a local JWKS
+// file whose keys are picked by the token's kid.
+func NewKeyfunc(algorithm, secret, publicKeyFile, jwksFile string) (jwt.Keyfunc, error) {
+	switch algorithm {
+	case jwt.SigningMethodHS256.Alg():
+		if secret == "" {
+			return nil, fmt.Errorf("%w: HS256 requires a secret", ErrNoSigningKey)
+		}
+		if len(secret) < minSecretLength {
+			return nil, fmt.Errorf("%w: HS256 secret must be at least %d bytes", ErrNoSigningKey, minSecretLength)
+		}
+		return func(*jwt.Token) (any, error) { return []byte(secret), nil }, nil
+	case jwt.SigningMethodRS256.Alg():
+		if jwksFile != "" {
+			keys, err := loadJWKS(jwksFile)
+			if err != nil {
+				return nil, err
+			}
+			return jwksKeyfunc(keys), nil
+		}
+		if publicKeyFile == "" {
+			return nil, fmt.Errorf("%w: RS256 requires a public key or a JWKS file", ErrNoSigningKey)
+		}
+
+		pem, err := os.ReadFile(publicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read public key: %w", err)
+		}
+		key, err := jwt.ParseRSAPublicKeyFromPEM(pem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse public key: %w", err)
+		}
+		return func(*jwt.Token) (any, error) { return key, nil }, nil
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q", algorithm)
+	}
+}
+
+// Auth authenticates requests with a bearer JWT and puts the user from the
+// "sub" claim into the request context. Requests already authenticated with
+// an API key pass through.
+func Auth(logger *zap.Logger, keyfunc jwt.Keyfunc, algorithm, issuer, audience string) func(http.Handler) http.Handler {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{algorithm}),
+		jwt.WithExpirationRequired(),
+	}
+	if issuer != "" {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		opts = append(opts, jwt.WithAudience(audience))
+	}
+	parser := jwt.NewParser(opts...)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, ok := UserID(r.Context()); ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			raw, ok := bearerToken(r)
+			if !ok {
+				unauthorized(w, "missing bearer token")
+				return
+			}
+
+			token, err := parser.Parse(raw, keyfunc)
+			if err != nil {
+				logger.Warn("invalid token", zap.Error(err))
+				unauthorized(w, "invalid token")
+				return
+			}
+
+			subject, err := token.Claims.GetSubject()
+			if err != nil {
+				unauthorized(w, "invalid token")
+				return
+			}
+			userID, err := strconv.Atoi(subject)
+			if err != nil || userID <= 0 {
+				logger.Warn("invalid token subject", zap.String("sub", subject))
+				unauthorized(w, "invalid token")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ContextWithUserID(r.Context(), userID)))
+		})
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+
+	return strings.TrimSpace(token), true
+}
+
+func unauthorized(w http.ResponseWriter, msg string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="calendar-service"`)
+	writeError(w, http.StatusUnauthorized, msg)
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": msg})
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func loadJWKS(path string) (map[string]*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS: %w", err)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err = json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+
+		n, errN := base64.RawURLEncoding.DecodeString(k.N)
+		e, errE := base64.RawURLEncoding.DecodeString(k.E)
+		if errN != nil || errE != nil {
+			return nil, fmt.Errorf("failed to parse JWKS: invalid key %q", k.Kid)
+		}
+
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: JWKS has no RSA signing keys", ErrNoSigningKey)
+	}
+
+	return keys, nil
+}
+
+// jwksKeyfunc picks the key named by the token's kid; a set with a single key
+// also accepts tokens without kid.
+func jwksKeyfunc(keys map[string]*rsa.PublicKey) jwt.Keyfunc {
+	return func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := keys[kid]; ok {
+			return key, nil
+		}
+		if kid == "" && len(keys) == 1 {
+			for _, key := range keys {
+				return key, nil
+			}
+		}
+
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, kid)
+	}
+}
@@ -0,0 +1,166 @@
+//go:build unit
+// +build unit
+
+package middlewares
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+const secret = "0123456789abcdef0123456789abcdef"
+
+func serveAuth(t *testing.T, keyfunc jwt.Keyfunc, algorithm, token string) (*httptest.ResponseRecorder, int) {
+	t.Helper()
+
+	var userID int
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ = UserID(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/events_for_day", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	Auth(zap.NewNop(), keyfunc, algorithm, "", "")(next).ServeHTTP(w, req)
+
+	return w, userID
+}
+
+func signHS256(t *testing.T, claims jwt.RegisteredClaims) string {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func TestAuthHS256(t *testing.T) {
+	keyfunc, err := NewKeyfunc("HS256", secret, "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	token := signHS256(t, jwt.RegisteredClaims{
+		Subject:   "7",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	})
+	w, userID := serveAuth(t, keyfunc, "HS256", token)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if userID != 7 {
+		t.Fatalf("expected user 7, got %d", userID)
+	}
+}
+
+func TestAuthRejects(t *testing.T) {
+	keyfunc, _ := NewKeyfunc("HS256", secret, "", "")
+	expiresAt := jwt.NewNumericDate(time.Now().Add(time.Hour))
+
+	none, _ := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.RegisteredClaims{Subject: "7", ExpiresAt: expiresAt}).
+		SignedString(jwt.UnsafeAllowNoneSignatureType)
+
+	for name, token := range map[string]string{
+		"missing": "",
+		"expired": signHS256(t, jwt.RegisteredClaims{
+			Subject: "7", ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+		}),
+		"no expiry":   signHS256(t, jwt.RegisteredClaims{Subject: "7"}),
+		"bad subject": signHS256(t, jwt.RegisteredClaims{Subject: "alice", ExpiresAt: expiresAt}),
+		"alg none":    none,
+		"garbage":     "not.a.token",
+	} {
+		w, _ := serveAuth(t, keyfunc, "HS256", token)
+		if w.Code != http.StatusUnauthorized {
+			t.Fatalf("%s: expected status %d, got %d", name, http.StatusUnauthorized, w.Code)
+		}
+		if w.Header().Get("WWW-Authenticate") == "" {
+			t.Fatalf("%s: expected WWW-Authenticate header", name)
+		}
+	}
+}
+
+func TestAuthRS256JWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	jwks := fmt.Sprintf(`{"keys":[{"kty":"RSA","kid":"k1","use":"sig","n":%q,"e":%q}]}`,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()))
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err = os.WriteFile(path, []byte(jwks), 0o600); err != nil {
+		t.Fatalf("failed to write JWKS: %v", err)
+	}
+
+	keyfunc, err := NewKeyfunc("RS256", "", "", path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sign := func(kid string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{
+			Subject:   strconv.Itoa(3),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		})
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatalf("failed to sign token: %v", err)
+		}
+		return signed
+	}
+
+	w, userID := serveAuth(t, keyfunc, "RS256", sign("k1"))
+	if w.Code != http.StatusOK || userID != 3 {
+		t.Fatalf("expected user 3 with status %d, got %d and %d", http.StatusOK, userID, w.Code)
+	}
+
+	w, _ = serveAuth(t, keyfunc, "RS256", sign("k2"))
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+
+	hs, _ := NewKeyfunc("HS256", secret, "", "")
+	w, _ = serveAuth(t, hs, "RS256", signHS256(t, jwt.RegisteredClaims{
+		Subject: "3", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}))
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected HS256 token to be rejected, got %d", w.Code)
+	}
+}
+
+func TestNewKeyfuncRequiresKey(t *testing.T) {
+	for _, algorithm := range []string{"HS256", "RS256"} {
+		if _, err := NewKeyfunc(algorithm, "", "", ""); err == nil {
+			t.Fatalf("%s: expected error", algorithm)
+		}
+	}
+	if _, err := NewKeyfunc("HS256", "secret", "", ""); !errors.Is(err, ErrNoSigningKey) {
+		t.Fatalf("expected ErrNoSigningKey for a short secret, got %v", err)
+	}
+	if _, err := NewKeyfunc("ES256", secret, "", ""); err == nil {
+		t.Fatal("expected error for unsupported algorithm")
+	}
+}
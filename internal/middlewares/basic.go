@@ -0,0 +1,58 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// BasicAuth lets calendar apps that only speak HTTP Basic sign in to CalDAV:
+// the user name is the user ID and the password an API key of that user.
+// Bearer tokens are passed on to the JWT middleware, and requests without
+// credentials are challenged for Basic ones.
+func BasicAuth(logger *zap.Logger, a apiKeyAuthenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, ok := UserID(r.Context()); ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			scheme, _, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+			if strings.EqualFold(scheme, "Bearer") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			username, password, ok := r.BasicAuth()
+			if !ok || password == "" {
+				basicUnauthorized(w, "missing credentials")
+				return
+			}
+
+			key, ok := authenticateKey(logger, a, w, r, password, basicUnauthorized)
+			if !ok {
+				return
+			}
+
+			if username != strconv.Itoa(key.UserID) {
+				logger.Warn("basic auth user does not own the api key", zap.String("username", username),
+					zap.Uint("key_id", key.ID))
+				basicUnauthorized(w, "invalid credentials")
+				return
+			}
+
+			ctx := ContextWithUserID(r.Context(), key.UserID)
+			ctx = context.WithValue(ctx, apiKeyCtxKey{}, key)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func basicUnauthorized(w http.ResponseWriter, msg string) {
+	w.Header().Set("WWW-Authenticate", `Basic realm="calendar-service", charset="UTF-8"`)
+	writeError(w, http.StatusUnauthorized, msg)
+}
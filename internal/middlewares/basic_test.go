@@ -0,0 +1,88 @@
+//go:build unit
+// +build unit
+
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	apiKeyR "github.com/avraam311/calendar-service/internal/repository/apikey"
+)
+
+func serveBasic(t *testing.T, a apiKeyAuthenticator, req *http.Request) (*httptest.ResponseRecorder, int) {
+	t.Helper()
+
+	var userID int
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ = UserID(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	BasicAuth(zap.NewNop(), a)(next).ServeHTTP(w, req)
+
+	return w, userID
+}
+
+func TestBasicAuthAPIKeyAsPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mocks.NewMockapiKeyAuthenticator(ctrl)
+	key := &models.APIKey{ID: 1, UserID: 5, Scopes: []string{models.APIKeyScopeRead, models.APIKeyScopeWrite}}
+	mockAuth.EXPECT().Authenticate(gomock.Any(), "cal_sync").Return(key, nil).Times(2)
+
+	req := httptest.NewRequest("PROPFIND", "/caldav/users/5/", nil)
+	req.SetBasicAuth("5", "cal_sync")
+	w, userID := serveBasic(t, mockAuth, req)
+	if w.Code != http.StatusOK || userID != 5 {
+		t.Fatalf("expected user 5 with status %d, got %d and %d", http.StatusOK, userID, w.Code)
+	}
+
+	req = httptest.NewRequest("PROPFIND", "/caldav/users/5/", nil)
+	req.SetBasicAuth("6", "cal_sync")
+	w, _ = serveBasic(t, mockAuth, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d for another user name, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
+
+func TestBasicAuthChallenges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuth := mocks.NewMockapiKeyAuthenticator(ctrl)
+	mockAuth.EXPECT().Authenticate(gomock.Any(), "cal_revoked").Return(nil, apiKeyR.ErrKeyNotFound)
+
+	req := httptest.NewRequest("PROPFIND", "/caldav/users/5/", nil)
+	w, _ := serveBasic(t, mockAuth, req)
+	if w.Code != http.StatusUnauthorized || w.Header().Get("WWW-Authenticate") == "" {
+		t.Fatalf("expected a Basic challenge, got %d %q", w.Code, w.Header().Get("WWW-Authenticate"))
+	}
+
+	req = httptest.NewRequest("PROPFIND", "/caldav/users/5/", nil)
+	req.SetBasicAuth("5", "cal_revoked")
+	w, _ = serveBasic(t, mockAuth, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
+
+func TestBasicAuthPassesBearer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest("PROPFIND", "/caldav/users/5/", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	w, userID := serveBasic(t, mocks.NewMockapiKeyAuthenticator(ctrl), req)
+	if w.Code != http.StatusOK || userID != 0 {
+		t.Fatalf("expected the request to pass to the JWT middleware, got %d and user %d", w.Code, userID)
+	}
+}
@@ -0,0 +1,119 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const maxQueryBodySize = 1 << 16
+
+// QueryParams serves the read endpoints to clients written when they took
+// their parameters from the JSON body of a GET request. Such a body is
+// deprecated: its fields are copied into the query string unless it already
+// has them, and the response carries a Deprecation header. A "user_id" given
+// either way must name the authenticated user, so it runs after Auth.
+func QueryParams(logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxQueryBodySize))
+			if err != nil {
+				logger.Warn("failed to read request body", zap.Error(err))
+				writeError(w, http.StatusBadRequest, "invalid request body")
+				return
+			}
+
+			if len(bytes.TrimSpace(body)) > 0 {
+				query := r.URL.Query()
+				if err := mergeBody(query, body); err != nil {
+					logger.Warn("failed to decode JSON", zap.Error(err))
+					writeError(w, http.StatusBadRequest, "invalid json")
+					return
+				}
+				r.URL.RawQuery = query.Encode()
+
+				logger.Warn("deprecated parameters in GET body", zap.String("url", r.URL.Path))
+				w.Header().Set("Deprecation", "true")
+			}
+
+			if s := r.URL.Query().Get("user_id"); s != "" {
+				authenticated, _ := UserID(r.Context())
+				if userID, err := strconv.Atoi(s); err != nil || userID != authenticated {
+					logger.Warn("user_id of another user", zap.String("user_id", s), zap.Int("authenticated", authenticated))
+					writeError(w, http.StatusForbidden, "user_id does not match the authenticated user")
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// mergeBody adds the fields of a JSON object missing from query. Strings,
+// numbers and booleans are taken as they are, arrays as comma-separated lists
+// and nulls are skipped.
+func mergeBody(query map[string][]string, body []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return err
+	}
+
+	for name, raw := range fields {
+		if _, ok := query[name]; ok || string(bytes.TrimSpace(raw)) == "null" {
+			continue
+		}
+
+		value, err := queryValue(raw)
+		if err != nil {
+			return err
+		}
+		query[name] = []string{value}
+	}
+
+	return nil
+}
+
+func queryValue(raw json.RawMessage) (string, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err == nil {
+		values := make([]string, 0, len(items))
+		for _, item := range items {
+			value, err := scalarValue(item)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, value)
+		}
+		return strings.Join(values, ","), nil
+	}
+
+	return scalarValue(raw)
+}
+
+func scalarValue(raw json.RawMessage) (string, error) {
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", err
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64, bool:
+		return string(bytes.TrimSpace(raw)), nil
+	default:
+		return "", errors.New("unsupported value " + string(raw))
+	}
+}
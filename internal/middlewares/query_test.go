@@ -0,0 +1,98 @@
+//go:build unit
+// +build unit
+
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func serveQueryParams(method, target, body string) (*httptest.ResponseRecorder, url.Values) {
+	var query url.Values
+	handler := QueryParams(zap.NewNop())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req = req.WithContext(ContextWithUserID(req.Context(), 1))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w, query
+}
+
+func TestQueryParamsWithoutBody(t *testing.T) {
+	w, query := serveQueryParams(http.MethodGet, "/events_for_day?date=2026-01-22", "")
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if w.Header().Get("Deprecation") != "" {
+		t.Fatal("unexpected Deprecation header")
+	}
+	if query.Get("date") != "2026-01-22" {
+		t.Fatalf("unexpected query %v", query)
+	}
+}
+
+func TestQueryParamsBodyFallback(t *testing.T) {
+	w, query := serveQueryParams(http.MethodGet, "/events_for_day?tz=Europe/Moscow",
+		`{"user_id": 1, "date": "2026-01-22", "tz": "UTC", "tags": ["work", "home"], "limit": null}`)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if w.Header().Get("Deprecation") != "true" {
+		t.Fatal("expected Deprecation header")
+	}
+	if query.Get("date") != "2026-01-22" || query.Get("user_id") != "1" || query.Get("tags") != "work,home" {
+		t.Fatalf("unexpected query %v", query)
+	}
+	if query.Get("tz") != "Europe/Moscow" {
+		t.Fatalf("query string must win over the body, got %v", query)
+	}
+	if query.Has("limit") {
+		t.Fatalf("null must be skipped, got %v", query)
+	}
+}
+
+func TestQueryParamsForeignUser(t *testing.T) {
+	for _, tc := range []struct{ target, body string }{
+		{"/events_for_day?date=2026-01-22&user_id=2", ""},
+		{"/events_for_day?date=2026-01-22", `{"user_id": 2}`},
+	} {
+		w, _ := serveQueryParams(http.MethodGet, tc.target, tc.body)
+
+		if w.Code != http.StatusForbidden {
+			t.Fatalf("%s %s: expected status %d, got %d", tc.target, tc.body, http.StatusForbidden, w.Code)
+		}
+	}
+}
+
+func TestQueryParamsInvalidBody(t *testing.T) {
+	for _, body := range []string{`{"date":`, `{"date": {"day": 22}}`} {
+		w, _ := serveQueryParams(http.MethodGet, "/events_for_day", body)
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected status %d, got %d", body, http.StatusBadRequest, w.Code)
+		}
+	}
+}
+
+func TestQueryParamsIgnoresOtherMethods(t *testing.T) {
+	w, query := serveQueryParams(http.MethodPost, "/create_event", `{"user_id": 2, "title": "Review"}`)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if query.Has("title") {
+		t.Fatalf("unexpected query %v", query)
+	}
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/avraam311/calendar-service/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockapiKeyService is a mock of apiKeyService interface.
+type MockapiKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiKeyServiceMockRecorder
+}
+
+// MockapiKeyServiceMockRecorder is the mock recorder for MockapiKeyService.
+type MockapiKeyServiceMockRecorder struct {
+	mock *MockapiKeyService
+}
+
+// NewMockapiKeyService creates a new mock instance.
+func NewMockapiKeyService(ctrl *gomock.Controller) *MockapiKeyService {
+	mock := &MockapiKeyService{ctrl: ctrl}
+	mock.recorder = &MockapiKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapiKeyService) EXPECT() *MockapiKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateKey mocks base method.
+func (m *MockapiKeyService) CreateKey(ctx context.Context, keyCreate *models.APIKeyCreate) (*models.APIKeyIssued, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKey", ctx, keyCreate)
+	ret0, _ := ret[0].(*models.APIKeyIssued)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKey indicates an expected call of CreateKey.
+func (mr *MockapiKeyServiceMockRecorder) CreateKey(ctx, keyCreate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockapiKeyService)(nil).CreateKey), ctx, keyCreate)
+}
+
+// GetKeys mocks base method.
+func (m *MockapiKeyService) GetKeys(ctx context.Context, userID int) ([]*models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeys", ctx, userID)
+	ret0, _ := ret[0].([]*models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeys indicates an expected call of GetKeys.
+func (mr *MockapiKeyServiceMockRecorder) GetKeys(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeys", reflect.TypeOf((*MockapiKeyService)(nil).GetKeys), ctx, userID)
+}
+
+// RevokeKey mocks base method.
+func (m *MockapiKeyService) RevokeKey(ctx context.Context, userID int, ID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeKey", ctx, userID, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeKey indicates an expected call of RevokeKey.
+func (mr *MockapiKeyServiceMockRecorder) RevokeKey(ctx, userID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeKey", reflect.TypeOf((*MockapiKeyService)(nil).RevokeKey), ctx, userID, ID)
+}
+
+// RotateKey mocks base method.
+func (m *MockapiKeyService) RotateKey(ctx context.Context, userID int, ID uint) (*models.APIKeyIssued, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateKey", ctx, userID, ID)
+	ret0, _ := ret[0].(*models.APIKeyIssued)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateKey indicates an expected call of RotateKey.
+func (mr *MockapiKeyServiceMockRecorder) RotateKey(ctx, userID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKey", reflect.TypeOf((*MockapiKeyService)(nil).RotateKey), ctx, userID, ID)
+}
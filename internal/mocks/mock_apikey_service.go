@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/avraam311/calendar-service/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockapiKeyRepo is a mock of apiKeyRepo interface.
+type MockapiKeyRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiKeyRepoMockRecorder
+}
+
+// MockapiKeyRepoMockRecorder is the mock recorder for MockapiKeyRepo.
+type MockapiKeyRepoMockRecorder struct {
+	mock *MockapiKeyRepo
+}
+
+// NewMockapiKeyRepo creates a new mock instance.
+func NewMockapiKeyRepo(ctrl *gomock.Controller) *MockapiKeyRepo {
+	mock := &MockapiKeyRepo{ctrl: ctrl}
+	mock.recorder = &MockapiKeyRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapiKeyRepo) EXPECT() *MockapiKeyRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateKey mocks base method.
+func (m *MockapiKeyRepo) CreateKey(ctx context.Context, key *models.APIKey, hash string) (*models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKey", ctx, key, hash)
+	ret0, _ := ret[0].(*models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKey indicates an expected call of CreateKey.
+func (mr *MockapiKeyRepoMockRecorder) CreateKey(ctx, key, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockapiKeyRepo)(nil).CreateKey), ctx, key, hash)
+}
+
+// GetKeys mocks base method.
+func (m *MockapiKeyRepo) GetKeys(ctx context.Context, userID int) ([]*models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeys", ctx, userID)
+	ret0, _ := ret[0].([]*models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeys indicates an expected call of GetKeys.
+func (mr *MockapiKeyRepoMockRecorder) GetKeys(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeys", reflect.TypeOf((*MockapiKeyRepo)(nil).GetKeys), ctx, userID)
+}
+
+// RevokeKey mocks base method.
+func (m *MockapiKeyRepo) RevokeKey(ctx context.Context, userID int, ID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeKey", ctx, userID, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeKey indicates an expected call of RevokeKey.
+func (mr *MockapiKeyRepoMockRecorder) RevokeKey(ctx, userID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeKey", reflect.TypeOf((*MockapiKeyRepo)(nil).RevokeKey), ctx, userID, ID)
+}
+
+// RotateKey mocks base method.
+func (m *MockapiKeyRepo) RotateKey(ctx context.Context, userID int, ID uint, prefix, hash string) (*models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateKey", ctx, userID, ID, prefix, hash)
+	ret0, _ := ret[0].(*models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateKey indicates an expected call of RotateKey.
+func (mr *MockapiKeyRepoMockRecorder) RotateKey(ctx, userID, ID, prefix, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKey", reflect.TypeOf((*MockapiKeyRepo)(nil).RotateKey), ctx, userID, ID, prefix, hash)
+}
+
+// UseKey mocks base method.
+func (m *MockapiKeyRepo) UseKey(ctx context.Context, hash string) (*models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseKey", ctx, hash)
+	ret0, _ := ret[0].(*models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseKey indicates an expected call of UseKey.
+func (mr *MockapiKeyRepoMockRecorder) UseKey(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseKey", reflect.TypeOf((*MockapiKeyRepo)(nil).UseKey), ctx, hash)
+}
@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: worker.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/avraam311/calendar-service/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockarchiveRepo is a mock of archiveRepo interface.
+type MockarchiveRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockarchiveRepoMockRecorder
+}
+
+// MockarchiveRepoMockRecorder is the mock recorder for MockarchiveRepo.
+type MockarchiveRepoMockRecorder struct {
+	mock *MockarchiveRepo
+}
+
+// NewMockarchiveRepo creates a new mock instance.
+func NewMockarchiveRepo(ctrl *gomock.Controller) *MockarchiveRepo {
+	mock := &MockarchiveRepo{ctrl: ctrl}
+	mock.recorder = &MockarchiveRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarchiveRepo) EXPECT() *MockarchiveRepoMockRecorder {
+	return m.recorder
+}
+
+// ArchiveEvents mocks base method.
+func (m *MockarchiveRepo) ArchiveEvents(ctx context.Context, before time.Time, seriesIDs []uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveEvents", ctx, before, seriesIDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveEvents indicates an expected call of ArchiveEvents.
+func (mr *MockarchiveRepoMockRecorder) ArchiveEvents(ctx, before, seriesIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveEvents", reflect.TypeOf((*MockarchiveRepo)(nil).ArchiveEvents), ctx, before, seriesIDs)
+}
+
+// GetRecurringEventsBefore mocks base method.
+func (m *MockarchiveRepo) GetRecurringEventsBefore(ctx context.Context, before time.Time) ([]*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecurringEventsBefore", ctx, before)
+	ret0, _ := ret[0].([]*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecurringEventsBefore indicates an expected call of GetRecurringEventsBefore.
+func (mr *MockarchiveRepoMockRecorder) GetRecurringEventsBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecurringEventsBefore", reflect.TypeOf((*MockarchiveRepo)(nil).GetRecurringEventsBefore), ctx, before)
+}
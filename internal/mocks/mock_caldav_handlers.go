@@ -0,0 +1,212 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/avraam311/calendar-service/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockcaldavService is a mock of caldavService interface.
+type MockcaldavService struct {
+	ctrl     *gomock.Controller
+	recorder *MockcaldavServiceMockRecorder
+}
+
+// MockcaldavServiceMockRecorder is the mock recorder for MockcaldavService.
+type MockcaldavServiceMockRecorder struct {
+	mock *MockcaldavService
+}
+
+// NewMockcaldavService creates a new mock instance.
+func NewMockcaldavService(ctrl *gomock.Controller) *MockcaldavService {
+	mock := &MockcaldavService{ctrl: ctrl}
+	mock.recorder = &MockcaldavServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcaldavService) EXPECT() *MockcaldavServiceMockRecorder {
+	return m.recorder
+}
+
+// GetChanges mocks base method.
+func (m *MockcaldavService) GetChanges(ctx context.Context, userID int, since int64) ([]*models.EventChange, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChanges", ctx, userID, since)
+	ret0, _ := ret[0].([]*models.EventChange)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetChanges indicates an expected call of GetChanges.
+func (mr *MockcaldavServiceMockRecorder) GetChanges(ctx, userID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChanges", reflect.TypeOf((*MockcaldavService)(nil).GetChanges), ctx, userID, since)
+}
+
+// GetEvent mocks base method.
+func (m *MockcaldavService) GetEvent(ctx context.Context, userID int, UID string) (*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, userID, UID)
+	ret0, _ := ret[0].(*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockcaldavServiceMockRecorder) GetEvent(ctx, userID, UID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockcaldavService)(nil).GetEvent), ctx, userID, UID)
+}
+
+// GetEvents mocks base method.
+func (m *MockcaldavService) GetEvents(ctx context.Context, userID int) ([]*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", ctx, userID)
+	ret0, _ := ret[0].([]*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockcaldavServiceMockRecorder) GetEvents(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockcaldavService)(nil).GetEvents), ctx, userID)
+}
+
+// GetOverrides mocks base method.
+func (m *MockcaldavService) GetOverrides(ctx context.Context, userID int, UID string) ([]*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverrides", ctx, userID, UID)
+	ret0, _ := ret[0].([]*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverrides indicates an expected call of GetOverrides.
+func (mr *MockcaldavServiceMockRecorder) GetOverrides(ctx, userID, UID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverrides", reflect.TypeOf((*MockcaldavService)(nil).GetOverrides), ctx, userID, UID)
+}
+
+// GetSyncToken mocks base method.
+func (m *MockcaldavService) GetSyncToken(ctx context.Context, userID int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSyncToken", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSyncToken indicates an expected call of GetSyncToken.
+func (mr *MockcaldavServiceMockRecorder) GetSyncToken(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncToken", reflect.TypeOf((*MockcaldavService)(nil).GetSyncToken), ctx, userID)
+}
+
+// QueryEvents mocks base method.
+func (m *MockcaldavService) QueryEvents(ctx context.Context, userID int, from, to time.Time) ([]*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryEvents", ctx, userID, from, to)
+	ret0, _ := ret[0].([]*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryEvents indicates an expected call of QueryEvents.
+func (mr *MockcaldavServiceMockRecorder) QueryEvents(ctx, userID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryEvents", reflect.TypeOf((*MockcaldavService)(nil).QueryEvents), ctx, userID, from, to)
+}
+
+// MockeventWriter is a mock of eventWriter interface.
+type MockeventWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventWriterMockRecorder
+}
+
+// MockeventWriterMockRecorder is the mock recorder for MockeventWriter.
+type MockeventWriterMockRecorder struct {
+	mock *MockeventWriter
+}
+
+// NewMockeventWriter creates a new mock instance.
+func NewMockeventWriter(ctrl *gomock.Controller) *MockeventWriter {
+	mock := &MockeventWriter{ctrl: ctrl}
+	mock.recorder = &MockeventWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventWriter) EXPECT() *MockeventWriterMockRecorder {
+	return m.recorder
+}
+
+// CreateEvent mocks base method.
+func (m *MockeventWriter) CreateEvent(ctx context.Context, event *models.EventCreate) (uint, []*models.Warning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", ctx, event)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].([]*models.Warning)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateEvent indicates an expected call of CreateEvent.
+func (mr *MockeventWriterMockRecorder) CreateEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockeventWriter)(nil).CreateEvent), ctx, event)
+}
+
+// DeleteEvent mocks base method.
+func (m *MockeventWriter) DeleteEvent(ctx context.Context, eventDelete *models.EventDelete) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvent", ctx, eventDelete)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEvent indicates an expected call of DeleteEvent.
+func (mr *MockeventWriterMockRecorder) DeleteEvent(ctx, eventDelete interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockeventWriter)(nil).DeleteEvent), ctx, eventDelete)
+}
+
+// InTx mocks base method.
+func (m *MockeventWriter) InTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InTx indicates an expected call of InTx.
+func (mr *MockeventWriterMockRecorder) InTx(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InTx", reflect.TypeOf((*MockeventWriter)(nil).InTx), ctx, fn)
+}
+
+// UpdateEvent mocks base method.
+func (m *MockeventWriter) UpdateEvent(ctx context.Context, event *models.EventUpdate) (uint, []*models.Warning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEvent", ctx, event)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].([]*models.Warning)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateEvent indicates an expected call of UpdateEvent.
+func (mr *MockeventWriterMockRecorder) UpdateEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockeventWriter)(nil).UpdateEvent), ctx, event)
+}
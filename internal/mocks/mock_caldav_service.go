@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/avraam311/calendar-service/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockcaldavRepo is a mock of caldavRepo interface.
+type MockcaldavRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcaldavRepoMockRecorder
+}
+
+// MockcaldavRepoMockRecorder is the mock recorder for MockcaldavRepo.
+type MockcaldavRepoMockRecorder struct {
+	mock *MockcaldavRepo
+}
+
+// NewMockcaldavRepo creates a new mock instance.
+func NewMockcaldavRepo(ctrl *gomock.Controller) *MockcaldavRepo {
+	mock := &MockcaldavRepo{ctrl: ctrl}
+	mock.recorder = &MockcaldavRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcaldavRepo) EXPECT() *MockcaldavRepoMockRecorder {
+	return m.recorder
+}
+
+// GetChanges mocks base method.
+func (m *MockcaldavRepo) GetChanges(ctx context.Context, userID int, since, until int64) ([]*models.EventChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChanges", ctx, userID, since, until)
+	ret0, _ := ret[0].([]*models.EventChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChanges indicates an expected call of GetChanges.
+func (mr *MockcaldavRepoMockRecorder) GetChanges(ctx, userID, since, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChanges", reflect.TypeOf((*MockcaldavRepo)(nil).GetChanges), ctx, userID, since, until)
+}
+
+// GetEventByUID mocks base method.
+func (m *MockcaldavRepo) GetEventByUID(ctx context.Context, userID int, UID string) (*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventByUID", ctx, userID, UID)
+	ret0, _ := ret[0].(*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventByUID indicates an expected call of GetEventByUID.
+func (mr *MockcaldavRepoMockRecorder) GetEventByUID(ctx, userID, UID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByUID", reflect.TypeOf((*MockcaldavRepo)(nil).GetEventByUID), ctx, userID, UID)
+}
+
+// GetEvents mocks base method.
+func (m *MockcaldavRepo) GetEvents(ctx context.Context, userID int) ([]*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", ctx, userID)
+	ret0, _ := ret[0].([]*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockcaldavRepoMockRecorder) GetEvents(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockcaldavRepo)(nil).GetEvents), ctx, userID)
+}
+
+// GetOverrides mocks base method.
+func (m *MockcaldavRepo) GetOverrides(ctx context.Context, userID int, UID string) ([]*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverrides", ctx, userID, UID)
+	ret0, _ := ret[0].([]*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverrides indicates an expected call of GetOverrides.
+func (mr *MockcaldavRepoMockRecorder) GetOverrides(ctx, userID, UID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverrides", reflect.TypeOf((*MockcaldavRepo)(nil).GetOverrides), ctx, userID, UID)
+}
+
+// GetSyncToken mocks base method.
+func (m *MockcaldavRepo) GetSyncToken(ctx context.Context, userID int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSyncToken", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSyncToken indicates an expected call of GetSyncToken.
+func (mr *MockcaldavRepoMockRecorder) GetSyncToken(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncToken", reflect.TypeOf((*MockcaldavRepo)(nil).GetSyncToken), ctx, userID)
+}
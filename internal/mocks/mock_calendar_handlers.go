@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/avraam311/calendar-service/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockcalendarService is a mock of calendarService interface.
+type MockcalendarService struct {
+	ctrl     *gomock.Controller
+	recorder *MockcalendarServiceMockRecorder
+}
+
+// MockcalendarServiceMockRecorder is the mock recorder for MockcalendarService.
+type MockcalendarServiceMockRecorder struct {
+	mock *MockcalendarService
+}
+
+// NewMockcalendarService creates a new mock instance.
+func NewMockcalendarService(ctrl *gomock.Controller) *MockcalendarService {
+	mock := &MockcalendarService{ctrl: ctrl}
+	mock.recorder = &MockcalendarServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcalendarService) EXPECT() *MockcalendarServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateCalendar mocks base method.
+func (m *MockcalendarService) CreateCalendar(ctx context.Context, calendar *models.CalendarCreate) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCalendar", ctx, calendar)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCalendar indicates an expected call of CreateCalendar.
+func (mr *MockcalendarServiceMockRecorder) CreateCalendar(ctx, calendar interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendar", reflect.TypeOf((*MockcalendarService)(nil).CreateCalendar), ctx, calendar)
+}
+
+// GetCalendars mocks base method.
+func (m *MockcalendarService) GetCalendars(ctx context.Context, userID int) ([]*models.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendars", ctx, userID)
+	ret0, _ := ret[0].([]*models.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendars indicates an expected call of GetCalendars.
+func (mr *MockcalendarServiceMockRecorder) GetCalendars(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendars", reflect.TypeOf((*MockcalendarService)(nil).GetCalendars), ctx, userID)
+}
+
+// GetMembers mocks base method.
+func (m *MockcalendarService) GetMembers(ctx context.Context, userID int, calendarID uint) ([]*models.CalendarMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembers", ctx, userID, calendarID)
+	ret0, _ := ret[0].([]*models.CalendarMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembers indicates an expected call of GetMembers.
+func (mr *MockcalendarServiceMockRecorder) GetMembers(ctx, userID, calendarID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockcalendarService)(nil).GetMembers), ctx, userID, calendarID)
+}
+
+// RevokeAccess mocks base method.
+func (m *MockcalendarService) RevokeAccess(ctx context.Context, userID int, calendarID uint, memberID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccess", ctx, userID, calendarID, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccess indicates an expected call of RevokeAccess.
+func (mr *MockcalendarServiceMockRecorder) RevokeAccess(ctx, userID, calendarID, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccess", reflect.TypeOf((*MockcalendarService)(nil).RevokeAccess), ctx, userID, calendarID, memberID)
+}
+
+// ShareCalendar mocks base method.
+func (m *MockcalendarService) ShareCalendar(ctx context.Context, userID int, member *models.CalendarMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareCalendar", ctx, userID, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShareCalendar indicates an expected call of ShareCalendar.
+func (mr *MockcalendarServiceMockRecorder) ShareCalendar(ctx, userID, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareCalendar", reflect.TypeOf((*MockcalendarService)(nil).ShareCalendar), ctx, userID, member)
+}
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/avraam311/calendar-service/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockcalendarRepo is a mock of calendarRepo interface.
+type MockcalendarRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcalendarRepoMockRecorder
+}
+
+// MockcalendarRepoMockRecorder is the mock recorder for MockcalendarRepo.
+type MockcalendarRepoMockRecorder struct {
+	mock *MockcalendarRepo
+}
+
+// NewMockcalendarRepo creates a new mock instance.
+func NewMockcalendarRepo(ctrl *gomock.Controller) *MockcalendarRepo {
+	mock := &MockcalendarRepo{ctrl: ctrl}
+	mock.recorder = &MockcalendarRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcalendarRepo) EXPECT() *MockcalendarRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateCalendar mocks base method.
+func (m *MockcalendarRepo) CreateCalendar(ctx context.Context, calendar *models.CalendarCreate) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCalendar", ctx, calendar)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCalendar indicates an expected call of CreateCalendar.
+func (mr *MockcalendarRepoMockRecorder) CreateCalendar(ctx, calendar interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendar", reflect.TypeOf((*MockcalendarRepo)(nil).CreateCalendar), ctx, calendar)
+}
+
+// DeleteMember mocks base method.
+func (m *MockcalendarRepo) DeleteMember(ctx context.Context, calendarID uint, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMember", ctx, calendarID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMember indicates an expected call of DeleteMember.
+func (mr *MockcalendarRepoMockRecorder) DeleteMember(ctx, calendarID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockcalendarRepo)(nil).DeleteMember), ctx, calendarID, userID)
+}
+
+// GetCalendars mocks base method.
+func (m *MockcalendarRepo) GetCalendars(ctx context.Context, userID int) ([]*models.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendars", ctx, userID)
+	ret0, _ := ret[0].([]*models.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendars indicates an expected call of GetCalendars.
+func (mr *MockcalendarRepoMockRecorder) GetCalendars(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendars", reflect.TypeOf((*MockcalendarRepo)(nil).GetCalendars), ctx, userID)
+}
+
+// GetMembers mocks base method.
+func (m *MockcalendarRepo) GetMembers(ctx context.Context, calendarID uint) ([]*models.CalendarMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembers", ctx, calendarID)
+	ret0, _ := ret[0].([]*models.CalendarMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembers indicates an expected call of GetMembers.
+func (mr *MockcalendarRepoMockRecorder) GetMembers(ctx, calendarID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockcalendarRepo)(nil).GetMembers), ctx, calendarID)
+}
+
+// GetRole mocks base method.
+func (m *MockcalendarRepo) GetRole(ctx context.Context, calendarID uint, userID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, calendarID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockcalendarRepoMockRecorder) GetRole(ctx, calendarID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockcalendarRepo)(nil).GetRole), ctx, calendarID, userID)
+}
+
+// SetMember mocks base method.
+func (m *MockcalendarRepo) SetMember(ctx context.Context, member *models.CalendarMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMember indicates an expected call of SetMember.
+func (mr *MockcalendarRepoMockRecorder) SetMember(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMember", reflect.TypeOf((*MockcalendarRepo)(nil).SetMember), ctx, member)
+}
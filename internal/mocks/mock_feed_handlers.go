@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/avraam311/calendar-service/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockfeedService is a mock of feedService interface.
+type MockfeedService struct {
+	ctrl     *gomock.Controller
+	recorder *MockfeedServiceMockRecorder
+}
+
+// MockfeedServiceMockRecorder is the mock recorder for MockfeedService.
+type MockfeedServiceMockRecorder struct {
+	mock *MockfeedService
+}
+
+// NewMockfeedService creates a new mock instance.
+func NewMockfeedService(ctrl *gomock.Controller) *MockfeedService {
+	mock := &MockfeedService{ctrl: ctrl}
+	mock.recorder = &MockfeedServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfeedService) EXPECT() *MockfeedServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateToken mocks base method.
+func (m *MockfeedService) CreateToken(ctx context.Context, userID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateToken", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateToken indicates an expected call of CreateToken.
+func (mr *MockfeedServiceMockRecorder) CreateToken(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockfeedService)(nil).CreateToken), ctx, userID)
+}
+
+// GetFeed mocks base method.
+func (m *MockfeedService) GetFeed(ctx context.Context, token string) (*models.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, token)
+	ret0, _ := ret[0].(*models.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockfeedServiceMockRecorder) GetFeed(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockfeedService)(nil).GetFeed), ctx, token)
+}
+
+// RevokeToken mocks base method.
+func (m *MockfeedService) RevokeToken(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockfeedServiceMockRecorder) RevokeToken(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockfeedService)(nil).RevokeToken), ctx, userID)
+}
+
+// MockeventsGetter is a mock of eventsGetter interface.
+type MockeventsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventsGetterMockRecorder
+}
+
+// MockeventsGetterMockRecorder is the mock recorder for MockeventsGetter.
+type MockeventsGetterMockRecorder struct {
+	mock *MockeventsGetter
+}
+
+// NewMockeventsGetter creates a new mock instance.
+func NewMockeventsGetter(ctrl *gomock.Controller) *MockeventsGetter {
+	mock := &MockeventsGetter{ctrl: ctrl}
+	mock.recorder = &MockeventsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventsGetter) EXPECT() *MockeventsGetterMockRecorder {
+	return m.recorder
+}
+
+// GetEvents mocks base method.
+func (m *MockeventsGetter) GetEvents(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", ctx, eventGet)
+	ret0, _ := ret[0].([]*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockeventsGetterMockRecorder) GetEvents(ctx, eventGet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockeventsGetter)(nil).GetEvents), ctx, eventGet)
+}
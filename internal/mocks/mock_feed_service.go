@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/avraam311/calendar-service/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockfeedRepo is a mock of feedRepo interface.
+type MockfeedRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockfeedRepoMockRecorder
+}
+
+// MockfeedRepoMockRecorder is the mock recorder for MockfeedRepo.
+type MockfeedRepoMockRecorder struct {
+	mock *MockfeedRepo
+}
+
+// NewMockfeedRepo creates a new mock instance.
+func NewMockfeedRepo(ctrl *gomock.Controller) *MockfeedRepo {
+	mock := &MockfeedRepo{ctrl: ctrl}
+	mock.recorder = &MockfeedRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfeedRepo) EXPECT() *MockfeedRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteToken mocks base method.
+func (m *MockfeedRepo) DeleteToken(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteToken", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteToken indicates an expected call of DeleteToken.
+func (mr *MockfeedRepoMockRecorder) DeleteToken(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteToken", reflect.TypeOf((*MockfeedRepo)(nil).DeleteToken), ctx, userID)
+}
+
+// GetFeed mocks base method.
+func (m *MockfeedRepo) GetFeed(ctx context.Context, token string) (*models.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, token)
+	ret0, _ := ret[0].(*models.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockfeedRepoMockRecorder) GetFeed(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockfeedRepo)(nil).GetFeed), ctx, token)
+}
+
+// SaveToken mocks base method.
+func (m *MockfeedRepo) SaveToken(ctx context.Context, userID int, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveToken", ctx, userID, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveToken indicates an expected call of SaveToken.
+func (mr *MockfeedRepoMockRecorder) SaveToken(ctx, userID, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveToken", reflect.TypeOf((*MockfeedRepo)(nil).SaveToken), ctx, userID, token)
+}
@@ -36,12 +36,13 @@ func (m *MockeventService) EXPECT() *MockeventServiceMockRecorder {
 }
 
 // CreateEvent mocks base method.
-func (m *MockeventService) CreateEvent(ctx context.Context, event *models.EventCreate) (uint, error) {
+func (m *MockeventService) CreateEvent(ctx context.Context, event *models.EventCreate) (uint, []*models.Warning, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateEvent", ctx, event)
 	ret0, _ := ret[0].(uint)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].([]*models.Warning)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // CreateEvent indicates an expected call of CreateEvent.
@@ -50,19 +51,108 @@ func (mr *MockeventServiceMockRecorder) CreateEvent(ctx, event interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockeventService)(nil).CreateEvent), ctx, event)
 }
 
+// CreateTag mocks base method.
+func (m *MockeventService) CreateTag(ctx context.Context, tag *models.Tag) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", ctx, tag)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockeventServiceMockRecorder) CreateTag(ctx, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockeventService)(nil).CreateTag), ctx, tag)
+}
+
 // DeleteEvent mocks base method.
-func (m *MockeventService) DeleteEvent(ctx context.Context, ID uint) (uint, error) {
+func (m *MockeventService) DeleteEvent(ctx context.Context, eventDelete *models.EventDelete) (uint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEvent", ctx, ID)
+	ret := m.ctrl.Call(m, "DeleteEvent", ctx, eventDelete)
 	ret0, _ := ret[0].(uint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteEvent indicates an expected call of DeleteEvent.
-func (mr *MockeventServiceMockRecorder) DeleteEvent(ctx, ID interface{}) *gomock.Call {
+func (mr *MockeventServiceMockRecorder) DeleteEvent(ctx, eventDelete interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockeventService)(nil).DeleteEvent), ctx, eventDelete)
+}
+
+// DeleteTag mocks base method.
+func (m *MockeventService) DeleteTag(ctx context.Context, userID int, ID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, userID, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockeventServiceMockRecorder) DeleteTag(ctx, userID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockeventService)(nil).DeleteTag), ctx, userID, ID)
+}
+
+// FindSlots mocks base method.
+func (m *MockeventService) FindSlots(ctx context.Context, query *models.SlotQuery) ([]*models.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSlots", ctx, query)
+	ret0, _ := ret[0].([]*models.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSlots indicates an expected call of FindSlots.
+func (mr *MockeventServiceMockRecorder) FindSlots(ctx, query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockeventService)(nil).DeleteEvent), ctx, ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSlots", reflect.TypeOf((*MockeventService)(nil).FindSlots), ctx, query)
+}
+
+// FreeBusy mocks base method.
+func (m *MockeventService) FreeBusy(ctx context.Context, query *models.FreeBusyQuery) ([]*models.FreeBusy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreeBusy", ctx, query)
+	ret0, _ := ret[0].([]*models.FreeBusy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreeBusy indicates an expected call of FreeBusy.
+func (mr *MockeventServiceMockRecorder) FreeBusy(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreeBusy", reflect.TypeOf((*MockeventService)(nil).FreeBusy), ctx, query)
+}
+
+// GetArchivedEvents mocks base method.
+func (m *MockeventService) GetArchivedEvents(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedEvents", ctx, eventGet)
+	ret0, _ := ret[0].([]*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedEvents indicates an expected call of GetArchivedEvents.
+func (mr *MockeventServiceMockRecorder) GetArchivedEvents(ctx, eventGet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedEvents", reflect.TypeOf((*MockeventService)(nil).GetArchivedEvents), ctx, eventGet)
+}
+
+// GetAttendees mocks base method.
+func (m *MockeventService) GetAttendees(ctx context.Context, userID int, eventID uint) ([]*models.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendees", ctx, userID, eventID)
+	ret0, _ := ret[0].([]*models.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendees indicates an expected call of GetAttendees.
+func (mr *MockeventServiceMockRecorder) GetAttendees(ctx, userID, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendees", reflect.TypeOf((*MockeventService)(nil).GetAttendees), ctx, userID, eventID)
 }
 
 // GetEvents mocks base method.
@@ -80,13 +170,146 @@ func (mr *MockeventServiceMockRecorder) GetEvents(ctx, eventGet interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockeventService)(nil).GetEvents), ctx, eventGet)
 }
 
+// GetEventsPage mocks base method.
+func (m *MockeventService) GetEventsPage(ctx context.Context, eventGet *models.EventGet) (*models.EventPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsPage", ctx, eventGet)
+	ret0, _ := ret[0].(*models.EventPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsPage indicates an expected call of GetEventsPage.
+func (mr *MockeventServiceMockRecorder) GetEventsPage(ctx, eventGet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsPage", reflect.TypeOf((*MockeventService)(nil).GetEventsPage), ctx, eventGet)
+}
+
+// GetTags mocks base method.
+func (m *MockeventService) GetTags(ctx context.Context, userID int) ([]*models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx, userID)
+	ret0, _ := ret[0].([]*models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockeventServiceMockRecorder) GetTags(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockeventService)(nil).GetTags), ctx, userID)
+}
+
+// ImportEvents mocks base method.
+func (m *MockeventService) ImportEvents(ctx context.Context, events []*models.EventCreate) ([]*models.ImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportEvents", ctx, events)
+	ret0, _ := ret[0].([]*models.ImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportEvents indicates an expected call of ImportEvents.
+func (mr *MockeventServiceMockRecorder) ImportEvents(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEvents", reflect.TypeOf((*MockeventService)(nil).ImportEvents), ctx, events)
+}
+
+// InviteAttendees mocks base method.
+func (m *MockeventService) InviteAttendees(ctx context.Context, invite *models.EventInvite) ([]*models.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteAttendees", ctx, invite)
+	ret0, _ := ret[0].([]*models.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteAttendees indicates an expected call of InviteAttendees.
+func (mr *MockeventServiceMockRecorder) InviteAttendees(ctx, invite interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteAttendees", reflect.TypeOf((*MockeventService)(nil).InviteAttendees), ctx, invite)
+}
+
+// RemoveAttendee mocks base method.
+func (m *MockeventService) RemoveAttendee(ctx context.Context, userID int, eventID uint, attendeeID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAttendee", ctx, userID, eventID, attendeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAttendee indicates an expected call of RemoveAttendee.
+func (mr *MockeventServiceMockRecorder) RemoveAttendee(ctx, userID, eventID, attendeeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAttendee", reflect.TypeOf((*MockeventService)(nil).RemoveAttendee), ctx, userID, eventID, attendeeID)
+}
+
+// RespondToEvent mocks base method.
+func (m *MockeventService) RespondToEvent(ctx context.Context, rsvp *models.EventRSVP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondToEvent", ctx, rsvp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RespondToEvent indicates an expected call of RespondToEvent.
+func (mr *MockeventServiceMockRecorder) RespondToEvent(ctx, rsvp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondToEvent", reflect.TypeOf((*MockeventService)(nil).RespondToEvent), ctx, rsvp)
+}
+
+// SearchEvents mocks base method.
+func (m *MockeventService) SearchEvents(ctx context.Context, search *models.EventSearch) ([]*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEvents", ctx, search)
+	ret0, _ := ret[0].([]*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEvents indicates an expected call of SearchEvents.
+func (mr *MockeventServiceMockRecorder) SearchEvents(ctx, search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEvents", reflect.TypeOf((*MockeventService)(nil).SearchEvents), ctx, search)
+}
+
+// SetEventTags mocks base method.
+func (m *MockeventService) SetEventTags(ctx context.Context, eventTags *models.EventTags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEventTags", ctx, eventTags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEventTags indicates an expected call of SetEventTags.
+func (mr *MockeventServiceMockRecorder) SetEventTags(ctx, eventTags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventTags", reflect.TypeOf((*MockeventService)(nil).SetEventTags), ctx, eventTags)
+}
+
+// TagSummary mocks base method.
+func (m *MockeventService) TagSummary(ctx context.Context, eventGet *models.EventGet) ([]*models.TagCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagSummary", ctx, eventGet)
+	ret0, _ := ret[0].([]*models.TagCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagSummary indicates an expected call of TagSummary.
+func (mr *MockeventServiceMockRecorder) TagSummary(ctx, eventGet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagSummary", reflect.TypeOf((*MockeventService)(nil).TagSummary), ctx, eventGet)
+}
+
 // UpdateEvent mocks base method.
-func (m *MockeventService) UpdateEvent(ctx context.Context, event *models.Event) (uint, error) {
+func (m *MockeventService) UpdateEvent(ctx context.Context, event *models.EventUpdate) (uint, []*models.Warning, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateEvent", ctx, event)
 	ret0, _ := ret[0].(uint)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].([]*models.Warning)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // UpdateEvent indicates an expected call of UpdateEvent.
@@ -94,3 +317,17 @@ func (mr *MockeventServiceMockRecorder) UpdateEvent(ctx, event interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockeventService)(nil).UpdateEvent), ctx, event)
 }
+
+// UpdateTag mocks base method.
+func (m *MockeventService) UpdateTag(ctx context.Context, tag *models.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTag", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockeventServiceMockRecorder) UpdateTag(ctx, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockeventService)(nil).UpdateTag), ctx, tag)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: apikey.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/avraam311/calendar-service/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockapiKeyAuthenticator is a mock of apiKeyAuthenticator interface.
+type MockapiKeyAuthenticator struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiKeyAuthenticatorMockRecorder
+}
+
+// MockapiKeyAuthenticatorMockRecorder is the mock recorder for MockapiKeyAuthenticator.
+type MockapiKeyAuthenticatorMockRecorder struct {
+	mock *MockapiKeyAuthenticator
+}
+
+// NewMockapiKeyAuthenticator creates a new mock instance.
+func NewMockapiKeyAuthenticator(ctrl *gomock.Controller) *MockapiKeyAuthenticator {
+	mock := &MockapiKeyAuthenticator{ctrl: ctrl}
+	mock.recorder = &MockapiKeyAuthenticatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapiKeyAuthenticator) EXPECT() *MockapiKeyAuthenticatorMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockapiKeyAuthenticator) Authenticate(ctx context.Context, key string) (*models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, key)
+	ret0, _ := ret[0].(*models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockapiKeyAuthenticatorMockRecorder) Authenticate(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockapiKeyAuthenticator)(nil).Authenticate), ctx, key)
+}
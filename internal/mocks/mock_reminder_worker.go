@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: worker.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/avraam311/calendar-service/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockreminderRepo is a mock of reminderRepo interface.
+type MockreminderRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockreminderRepoMockRecorder
+}
+
+// MockreminderRepoMockRecorder is the mock recorder for MockreminderRepo.
+type MockreminderRepoMockRecorder struct {
+	mock *MockreminderRepo
+}
+
+// NewMockreminderRepo creates a new mock instance.
+func NewMockreminderRepo(ctrl *gomock.Controller) *MockreminderRepo {
+	mock := &MockreminderRepo{ctrl: ctrl}
+	mock.recorder = &MockreminderRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreminderRepo) EXPECT() *MockreminderRepoMockRecorder {
+	return m.recorder
+}
+
+// GetEventsWithReminders mocks base method.
+func (m *MockreminderRepo) GetEventsWithReminders(ctx context.Context, from, to time.Time) ([]*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsWithReminders", ctx, from, to)
+	ret0, _ := ret[0].([]*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsWithReminders indicates an expected call of GetEventsWithReminders.
+func (mr *MockreminderRepoMockRecorder) GetEventsWithReminders(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsWithReminders", reflect.TypeOf((*MockreminderRepo)(nil).GetEventsWithReminders), ctx, from, to)
+}
+
+// MarkSent mocks base method.
+func (m *MockreminderRepo) MarkSent(ctx context.Context, notification *models.Notification) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSent", ctx, notification)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkSent indicates an expected call of MarkSent.
+func (mr *MockreminderRepoMockRecorder) MarkSent(ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSent", reflect.TypeOf((*MockreminderRepo)(nil).MarkSent), ctx, notification)
+}
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(ctx context.Context, notification *models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), ctx, notification)
+}
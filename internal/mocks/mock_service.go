@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/avraam311/calendar-service/internal/models"
 	gomock "github.com/golang/mock/gomock"
@@ -35,6 +36,49 @@ func (m *MockeventRepo) EXPECT() *MockeventRepoMockRecorder {
 	return m.recorder
 }
 
+// AddAttendees mocks base method.
+func (m *MockeventRepo) AddAttendees(ctx context.Context, eventID uint, userIDs []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAttendees", ctx, eventID, userIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAttendees indicates an expected call of AddAttendees.
+func (mr *MockeventRepoMockRecorder) AddAttendees(ctx, eventID, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttendees", reflect.TypeOf((*MockeventRepo)(nil).AddAttendees), ctx, eventID, userIDs)
+}
+
+// CalendarPeers mocks base method.
+func (m *MockeventRepo) CalendarPeers(ctx context.Context, userID int, userIDs []int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalendarPeers", ctx, userID, userIDs)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalendarPeers indicates an expected call of CalendarPeers.
+func (mr *MockeventRepoMockRecorder) CalendarPeers(ctx, userID, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalendarPeers", reflect.TypeOf((*MockeventRepo)(nil).CalendarPeers), ctx, userID, userIDs)
+}
+
+// CopyAttendees mocks base method.
+func (m *MockeventRepo) CopyAttendees(ctx context.Context, fromID, toID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyAttendees", ctx, fromID, toID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyAttendees indicates an expected call of CopyAttendees.
+func (mr *MockeventRepoMockRecorder) CopyAttendees(ctx, fromID, toID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyAttendees", reflect.TypeOf((*MockeventRepo)(nil).CopyAttendees), ctx, fromID, toID)
+}
+
 // CreateEvent mocks base method.
 func (m *MockeventRepo) CreateEvent(ctx context.Context, event *models.EventCreate) (uint, error) {
 	m.ctrl.T.Helper()
@@ -50,19 +94,137 @@ func (mr *MockeventRepoMockRecorder) CreateEvent(ctx, event interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockeventRepo)(nil).CreateEvent), ctx, event)
 }
 
+// CreateTag mocks base method.
+func (m *MockeventRepo) CreateTag(ctx context.Context, tag *models.Tag) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", ctx, tag)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockeventRepoMockRecorder) CreateTag(ctx, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockeventRepo)(nil).CreateTag), ctx, tag)
+}
+
+// DeleteAttendee mocks base method.
+func (m *MockeventRepo) DeleteAttendee(ctx context.Context, eventID uint, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttendee", ctx, eventID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttendee indicates an expected call of DeleteAttendee.
+func (mr *MockeventRepoMockRecorder) DeleteAttendee(ctx, eventID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttendee", reflect.TypeOf((*MockeventRepo)(nil).DeleteAttendee), ctx, eventID, userID)
+}
+
 // DeleteEvent mocks base method.
-func (m *MockeventRepo) DeleteEvent(ctx context.Context, ID uint) (uint, error) {
+func (m *MockeventRepo) DeleteEvent(ctx context.Context, userID int, ID uint) (uint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEvent", ctx, ID)
+	ret := m.ctrl.Call(m, "DeleteEvent", ctx, userID, ID)
 	ret0, _ := ret[0].(uint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteEvent indicates an expected call of DeleteEvent.
-func (mr *MockeventRepoMockRecorder) DeleteEvent(ctx, ID interface{}) *gomock.Call {
+func (mr *MockeventRepoMockRecorder) DeleteEvent(ctx, userID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockeventRepo)(nil).DeleteEvent), ctx, userID, ID)
+}
+
+// DeleteTag mocks base method.
+func (m *MockeventRepo) DeleteTag(ctx context.Context, userID int, ID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, userID, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockeventRepoMockRecorder) DeleteTag(ctx, userID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockeventRepo)(nil).DeleteTag), ctx, userID, ID)
+}
+
+// GetArchivedEvents mocks base method.
+func (m *MockeventRepo) GetArchivedEvents(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedEvents", ctx, eventGet)
+	ret0, _ := ret[0].([]*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedEvents indicates an expected call of GetArchivedEvents.
+func (mr *MockeventRepoMockRecorder) GetArchivedEvents(ctx, eventGet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedEvents", reflect.TypeOf((*MockeventRepo)(nil).GetArchivedEvents), ctx, eventGet)
+}
+
+// GetAttendees mocks base method.
+func (m *MockeventRepo) GetAttendees(ctx context.Context, eventID uint) ([]*models.Attendee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendees", ctx, eventID)
+	ret0, _ := ret[0].([]*models.Attendee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendees indicates an expected call of GetAttendees.
+func (mr *MockeventRepoMockRecorder) GetAttendees(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendees", reflect.TypeOf((*MockeventRepo)(nil).GetAttendees), ctx, eventID)
+}
+
+// GetAvailability mocks base method.
+func (m *MockeventRepo) GetAvailability(ctx context.Context, userIDs []int, from, to time.Time) ([]*models.Availability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailability", ctx, userIDs, from, to)
+	ret0, _ := ret[0].([]*models.Availability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailability indicates an expected call of GetAvailability.
+func (mr *MockeventRepoMockRecorder) GetAvailability(ctx, userIDs, from, to interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockeventRepo)(nil).DeleteEvent), ctx, ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailability", reflect.TypeOf((*MockeventRepo)(nil).GetAvailability), ctx, userIDs, from, to)
+}
+
+// GetEvent mocks base method.
+func (m *MockeventRepo) GetEvent(ctx context.Context, userID int, ID uint) (*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, userID, ID)
+	ret0, _ := ret[0].(*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockeventRepoMockRecorder) GetEvent(ctx, userID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockeventRepo)(nil).GetEvent), ctx, userID, ID)
+}
+
+// GetEventByUID mocks base method.
+func (m *MockeventRepo) GetEventByUID(ctx context.Context, userID int, UID string) (*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventByUID", ctx, userID, UID)
+	ret0, _ := ret[0].(*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventByUID indicates an expected call of GetEventByUID.
+func (mr *MockeventRepoMockRecorder) GetEventByUID(ctx, userID, UID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByUID", reflect.TypeOf((*MockeventRepo)(nil).GetEventByUID), ctx, userID, UID)
 }
 
 // GetEvents mocks base method.
@@ -80,6 +242,78 @@ func (mr *MockeventRepoMockRecorder) GetEvents(ctx, eventGet interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockeventRepo)(nil).GetEvents), ctx, eventGet)
 }
 
+// GetTags mocks base method.
+func (m *MockeventRepo) GetTags(ctx context.Context, userID int) ([]*models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx, userID)
+	ret0, _ := ret[0].([]*models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockeventRepoMockRecorder) GetTags(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockeventRepo)(nil).GetTags), ctx, userID)
+}
+
+// InTx mocks base method.
+func (m *MockeventRepo) InTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InTx indicates an expected call of InTx.
+func (mr *MockeventRepoMockRecorder) InTx(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InTx", reflect.TypeOf((*MockeventRepo)(nil).InTx), ctx, fn)
+}
+
+// SearchEvents mocks base method.
+func (m *MockeventRepo) SearchEvents(ctx context.Context, search *models.EventSearch, tsQuery string) ([]*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEvents", ctx, search, tsQuery)
+	ret0, _ := ret[0].([]*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEvents indicates an expected call of SearchEvents.
+func (mr *MockeventRepoMockRecorder) SearchEvents(ctx, search, tsQuery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEvents", reflect.TypeOf((*MockeventRepo)(nil).SearchEvents), ctx, search, tsQuery)
+}
+
+// SetAttendeeStatus mocks base method.
+func (m *MockeventRepo) SetAttendeeStatus(ctx context.Context, rsvp *models.EventRSVP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAttendeeStatus", ctx, rsvp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAttendeeStatus indicates an expected call of SetAttendeeStatus.
+func (mr *MockeventRepoMockRecorder) SetAttendeeStatus(ctx, rsvp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAttendeeStatus", reflect.TypeOf((*MockeventRepo)(nil).SetAttendeeStatus), ctx, rsvp)
+}
+
+// SetEventTags mocks base method.
+func (m *MockeventRepo) SetEventTags(ctx context.Context, eventTags *models.EventTags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEventTags", ctx, eventTags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEventTags indicates an expected call of SetEventTags.
+func (mr *MockeventRepoMockRecorder) SetEventTags(ctx, eventTags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventTags", reflect.TypeOf((*MockeventRepo)(nil).SetEventTags), ctx, eventTags)
+}
+
 // UpdateEvent mocks base method.
 func (m *MockeventRepo) UpdateEvent(ctx context.Context, event *models.Event) (uint, error) {
 	m.ctrl.T.Helper()
@@ -94,3 +328,32 @@ func (mr *MockeventRepoMockRecorder) UpdateEvent(ctx, event interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockeventRepo)(nil).UpdateEvent), ctx, event)
 }
+
+// UpdateTag mocks base method.
+func (m *MockeventRepo) UpdateTag(ctx context.Context, tag *models.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTag", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockeventRepoMockRecorder) UpdateTag(ctx, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockeventRepo)(nil).UpdateTag), ctx, tag)
+}
+
+// WritableCalendar mocks base method.
+func (m *MockeventRepo) WritableCalendar(ctx context.Context, userID int, calendarID uint) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WritableCalendar", ctx, userID, calendarID)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WritableCalendar indicates an expected call of WritableCalendar.
+func (mr *MockeventRepoMockRecorder) WritableCalendar(ctx, userID, calendarID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritableCalendar", reflect.TypeOf((*MockeventRepo)(nil).WritableCalendar), ctx, userID, calendarID)
+}
@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/avraam311/calendar-service/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MocksettingsService is a mock of settingsService interface.
+type MocksettingsService struct {
+	ctrl     *gomock.Controller
+	recorder *MocksettingsServiceMockRecorder
+}
+
+// MocksettingsServiceMockRecorder is the mock recorder for MocksettingsService.
+type MocksettingsServiceMockRecorder struct {
+	mock *MocksettingsService
+}
+
+// NewMocksettingsService creates a new mock instance.
+func NewMocksettingsService(ctrl *gomock.Controller) *MocksettingsService {
+	mock := &MocksettingsService{ctrl: ctrl}
+	mock.recorder = &MocksettingsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksettingsService) EXPECT() *MocksettingsServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateOutOfOffice mocks base method.
+func (m *MocksettingsService) CreateOutOfOffice(ctx context.Context, ooo *models.OutOfOffice) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutOfOffice", ctx, ooo)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOutOfOffice indicates an expected call of CreateOutOfOffice.
+func (mr *MocksettingsServiceMockRecorder) CreateOutOfOffice(ctx, ooo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutOfOffice", reflect.TypeOf((*MocksettingsService)(nil).CreateOutOfOffice), ctx, ooo)
+}
+
+// DeleteOutOfOffice mocks base method.
+func (m *MocksettingsService) DeleteOutOfOffice(ctx context.Context, userID int, ID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOutOfOffice", ctx, userID, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOutOfOffice indicates an expected call of DeleteOutOfOffice.
+func (mr *MocksettingsServiceMockRecorder) DeleteOutOfOffice(ctx, userID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOutOfOffice", reflect.TypeOf((*MocksettingsService)(nil).DeleteOutOfOffice), ctx, userID, ID)
+}
+
+// DeleteSettings mocks base method.
+func (m *MocksettingsService) DeleteSettings(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSettings", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSettings indicates an expected call of DeleteSettings.
+func (mr *MocksettingsServiceMockRecorder) DeleteSettings(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSettings", reflect.TypeOf((*MocksettingsService)(nil).DeleteSettings), ctx, userID)
+}
+
+// GetOutOfOffice mocks base method.
+func (m *MocksettingsService) GetOutOfOffice(ctx context.Context, userID int) ([]*models.OutOfOffice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutOfOffice", ctx, userID)
+	ret0, _ := ret[0].([]*models.OutOfOffice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutOfOffice indicates an expected call of GetOutOfOffice.
+func (mr *MocksettingsServiceMockRecorder) GetOutOfOffice(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutOfOffice", reflect.TypeOf((*MocksettingsService)(nil).GetOutOfOffice), ctx, userID)
+}
+
+// GetSettings mocks base method.
+func (m *MocksettingsService) GetSettings(ctx context.Context, userID int) (*models.UserSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettings", ctx, userID)
+	ret0, _ := ret[0].(*models.UserSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettings indicates an expected call of GetSettings.
+func (mr *MocksettingsServiceMockRecorder) GetSettings(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettings", reflect.TypeOf((*MocksettingsService)(nil).GetSettings), ctx, userID)
+}
+
+// SaveSettings mocks base method.
+func (m *MocksettingsService) SaveSettings(ctx context.Context, settings *models.UserSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSettings", ctx, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSettings indicates an expected call of SaveSettings.
+func (mr *MocksettingsServiceMockRecorder) SaveSettings(ctx, settings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSettings", reflect.TypeOf((*MocksettingsService)(nil).SaveSettings), ctx, settings)
+}
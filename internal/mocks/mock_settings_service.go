@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/avraam311/calendar-service/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MocksettingsRepo is a mock of settingsRepo interface.
+type MocksettingsRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MocksettingsRepoMockRecorder
+}
+
+// MocksettingsRepoMockRecorder is the mock recorder for MocksettingsRepo.
+type MocksettingsRepoMockRecorder struct {
+	mock *MocksettingsRepo
+}
+
+// NewMocksettingsRepo creates a new mock instance.
+func NewMocksettingsRepo(ctrl *gomock.Controller) *MocksettingsRepo {
+	mock := &MocksettingsRepo{ctrl: ctrl}
+	mock.recorder = &MocksettingsRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksettingsRepo) EXPECT() *MocksettingsRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateOutOfOffice mocks base method.
+func (m *MocksettingsRepo) CreateOutOfOffice(ctx context.Context, ooo *models.OutOfOffice) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutOfOffice", ctx, ooo)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOutOfOffice indicates an expected call of CreateOutOfOffice.
+func (mr *MocksettingsRepoMockRecorder) CreateOutOfOffice(ctx, ooo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutOfOffice", reflect.TypeOf((*MocksettingsRepo)(nil).CreateOutOfOffice), ctx, ooo)
+}
+
+// DeleteOutOfOffice mocks base method.
+func (m *MocksettingsRepo) DeleteOutOfOffice(ctx context.Context, userID int, ID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOutOfOffice", ctx, userID, ID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOutOfOffice indicates an expected call of DeleteOutOfOffice.
+func (mr *MocksettingsRepoMockRecorder) DeleteOutOfOffice(ctx, userID, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOutOfOffice", reflect.TypeOf((*MocksettingsRepo)(nil).DeleteOutOfOffice), ctx, userID, ID)
+}
+
+// DeleteSettings mocks base method.
+func (m *MocksettingsRepo) DeleteSettings(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSettings", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSettings indicates an expected call of DeleteSettings.
+func (mr *MocksettingsRepoMockRecorder) DeleteSettings(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSettings", reflect.TypeOf((*MocksettingsRepo)(nil).DeleteSettings), ctx, userID)
+}
+
+// GetOutOfOffice mocks base method.
+func (m *MocksettingsRepo) GetOutOfOffice(ctx context.Context, userID int) ([]*models.OutOfOffice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutOfOffice", ctx, userID)
+	ret0, _ := ret[0].([]*models.OutOfOffice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutOfOffice indicates an expected call of GetOutOfOffice.
+func (mr *MocksettingsRepoMockRecorder) GetOutOfOffice(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutOfOffice", reflect.TypeOf((*MocksettingsRepo)(nil).GetOutOfOffice), ctx, userID)
+}
+
+// GetSettings mocks base method.
+func (m *MocksettingsRepo) GetSettings(ctx context.Context, userID int) (*models.UserSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettings", ctx, userID)
+	ret0, _ := ret[0].(*models.UserSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettings indicates an expected call of GetSettings.
+func (mr *MocksettingsRepoMockRecorder) GetSettings(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettings", reflect.TypeOf((*MocksettingsRepo)(nil).GetSettings), ctx, userID)
+}
+
+// SaveSettings mocks base method.
+func (m *MocksettingsRepo) SaveSettings(ctx context.Context, settings *models.UserSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSettings", ctx, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSettings indicates an expected call of SaveSettings.
+func (mr *MocksettingsRepoMockRecorder) SaveSettings(ctx, settings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSettings", reflect.TypeOf((*MocksettingsRepo)(nil).SaveSettings), ctx, settings)
+}
@@ -2,29 +2,353 @@ package models
 
 import "time"
 
+const (
+	ScopeThis      = "this"
+	ScopeFollowing = "following"
+	ScopeAll       = "all"
+)
+
+const (
+	CalendarRoleOwner    = "owner"
+	CalendarRoleEditor   = "editor"
+	CalendarRoleViewer   = "viewer"
+	CalendarRoleFreeBusy = "freebusy"
+)
+
+const (
+	AttendeeNeedsAction = "needs-action"
+	AttendeeAccepted    = "accepted"
+	AttendeeDeclined    = "declined"
+	AttendeeTentative   = "tentative"
+)
+
+const (
+	APIKeyScopeRead  = "read"
+	APIKeyScopeWrite = "write"
+)
+
+const (
+	ConflictWarn   = "warn"
+	ConflictReject = "reject"
+)
+
+const (
+	WarningConflict            = "conflict"
+	WarningOutsideWorkingHours = "outside_working_hours"
+	WarningOutOfOffice         = "out_of_office"
+)
+
+const (
+	RankEarliest        = "earliest"
+	RankFewestConflicts = "fewest_conflicts"
+)
+
+const (
+	SortDateAsc  = "date"
+	SortDateDesc = "-date"
+)
+
+const (
+	ImportCreated = "created"
+	ImportUpdated = "updated"
+	ImportSkipped = "skipped"
+)
+
 type EventDelete struct {
-	ID uint `json:"id" validate:"required"`
+	ID           uint       `json:"id" validate:"required"`
+	UserID       int        `json:"-"`
+	Scope        string     `json:"scope" validate:"omitempty,oneof=this following all"`
+	RecurrenceID *time.Time `json:"recurrence_id" validate:"required_if=Scope this,required_if=Scope following"`
 }
 
 type EventCreate struct {
-	UserID int       `json:"user_id" validate:"required"`
-	Event  string    `json:"event" validate:"required"`
-	Date   time.Time `json:"date" validate:"required"`
+	UserID      int            `json:"-" validate:"required"`
+	Title       string         `json:"title" validate:"required,max=255"`
+	Description string         `json:"description,omitempty" validate:"max=10000"`
+	Location    string         `json:"location,omitempty" validate:"max=500"`
+	URL         string         `json:"url,omitempty" validate:"omitempty,max=2048,http_url"`
+	Metadata    map[string]any `json:"metadata,omitempty" validate:"max=50"`
+	Date        time.Time      `json:"date" validate:"required"`
+	EndDate     time.Time      `json:"end_date" validate:"omitempty,gtefield=Date"`
+	AllDay      bool           `json:"all_day"`
+	TimeZone    string         `json:"time_zone" validate:"omitempty,timezone"`
+	Reminders   []int          `json:"reminders,omitempty" validate:"max=10,dive,gt=0,lte=10080"`
+	RRule       string         `json:"rrule,omitempty" validate:"omitempty,rrule"`
+	ExDates     []time.Time    `json:"exdates,omitempty"`
+	UID         string         `json:"-"`
+	// CalendarID is the calendar the event goes to; zero means the user's
+	// default calendar.
+	CalendarID uint   `json:"calendar_id"`
+	OnConflict string `json:"on_conflict" validate:"omitempty,oneof=warn reject"`
 }
 
 type Event struct {
-	ID     uint      `json:"id" validate:"required"`
-	UserID int       `json:"user_id" validate:"required"`
-	Event  string    `json:"event" validate:"required"`
-	Date   time.Time `json:"date" validate:"required"`
+	ID          uint   `json:"id" validate:"required"`
+	UserID      int    `json:"user_id" validate:"required"`
+	CalendarID  uint   `json:"calendar_id"`
+	Title       string `json:"title" validate:"required,max=255"`
+	Description string `json:"description,omitempty" validate:"max=10000"`
+	Location    string `json:"location,omitempty" validate:"max=500"`
+	URL         string `json:"url,omitempty" validate:"omitempty,max=2048,http_url"`
+	// Metadata is free-form data of the client, stored as is.
+	Metadata     map[string]any `json:"metadata,omitempty" validate:"max=50"`
+	Date         time.Time      `json:"date" validate:"required"`
+	EndDate      time.Time      `json:"end_date" validate:"omitempty,gtefield=Date"`
+	AllDay       bool           `json:"all_day"`
+	TimeZone     string         `json:"time_zone" validate:"omitempty,timezone"`
+	Reminders    []int          `json:"reminders,omitempty" validate:"max=10,dive,gt=0,lte=10080"`
+	RRule        string         `json:"rrule,omitempty" validate:"omitempty,rrule"`
+	ExDates      []time.Time    `json:"exdates,omitempty"`
+	RecurrenceID *time.Time     `json:"recurrence_id,omitempty"`
+	// RSVPStatus is the requesting user's answer when they are invited to
+	// the event.
+	RSVPStatus string `json:"rsvp_status,omitempty"`
+	// Tags are the names of the requesting user's tags on the event.
+	Tags []string `json:"tags,omitempty"`
+	// OutsideWorkingHours flags events that fall outside the requesting
+	// user's working hours.
+	OutsideWorkingHours bool   `json:"outside_working_hours,omitempty"`
+	UID                 string `json:"-"`
+	Version             int64  `json:"-"`
 }
 
-type EventGetUserID struct {
-	UserID int `json:"user_id" validate:"required"`
+type EventUpdate struct {
+	Event
+	Scope      string `json:"scope" validate:"omitempty,oneof=this following all"`
+	OnConflict string `json:"on_conflict" validate:"omitempty,oneof=warn reject"`
+}
+
+// Warning reports a problem that did not stop the request, such as an event
+// overlapping another one.
+type Warning struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Event   *Event `json:"event,omitempty"`
 }
 
 type EventGet struct {
 	UserID   int       `json:"user_id" validate:"required"`
 	DateFrom time.Time `json:"date_from"`
 	DateTo   time.Time `json:"date_to"`
+	// Tags keeps only events with any of the tags; ExcludeTags drops events
+	// with any of them.
+	Tags        []string `json:"tags" validate:"max=20,dive,max=50"`
+	ExcludeTags []string `json:"exclude_tags" validate:"max=20,dive,max=50"`
+	// OwnerIDs keeps only events created by the users and CalendarIDs only
+	// events of the calendars. Query is a full-text query in the syntax of
+	// EventSearch; the service turns it into TSQuery.
+	OwnerIDs    []int  `json:"user_ids" validate:"max=50,dive,gt=0"`
+	CalendarIDs []uint `json:"calendar_ids" validate:"max=50,dive,gt=0"`
+	Query       string `json:"q" validate:"max=200"`
+	TSQuery     string `json:"-"`
+	// Sort orders occurrences by date, ascending unless it is SortDateDesc.
+	Sort string `json:"sort" validate:"omitempty,oneof=date -date"`
+	// Limit caps the page of occurrences, zero means no limit. Cursor is the
+	// opaque position returned with the previous page; the service decodes it
+	// into After.
+	Limit  int          `json:"limit" validate:"omitempty,gt=0,lte=500"`
+	Cursor string       `json:"cursor" validate:"max=100"`
+	After  *EventCursor `json:"-"`
+}
+
+// EventCursor is a position in the occurrences ordered by date and ID.
+type EventCursor struct {
+	Date time.Time
+	ID   uint
+}
+
+type EventPage struct {
+	Events     []*Event `json:"result"`
+	NextCursor string   `json:"next_cursor,omitempty"`
+}
+
+// EventSearch looks for the user's events matching Query. The window is
+// optional: a zero DateFrom or DateTo leaves that side open.
+type EventSearch struct {
+	UserID   int       `json:"-"`
+	Query    string    `json:"q" validate:"required,max=200"`
+	DateFrom time.Time `json:"from"`
+	DateTo   time.Time `json:"to"`
+	Limit    int       `json:"limit" validate:"omitempty,gt=0,lte=100"`
+}
+
+type Notification struct {
+	EventID       uint      `json:"event_id"`
+	UserID        int       `json:"user_id"`
+	Event         string    `json:"event"`
+	Date          time.Time `json:"date"`
+	MinutesBefore int       `json:"minutes_before"`
+}
+
+type ImportResult struct {
+	UID    string `json:"uid"`
+	ID     uint   `json:"id,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type Feed struct {
+	UserID     int       `json:"user_id"`
+	ModifiedAt time.Time `json:"modified_at"`
+}
+
+type FeedToken struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+type EventChange struct {
+	EventID uint
+	UID     string
+	Version int64
+	Deleted bool
+}
+
+type APIKey struct {
+	ID         uint       `json:"id"`
+	UserID     int        `json:"-"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+type APIKeyCreate struct {
+	UserID int      `json:"-" validate:"required"`
+	Name   string   `json:"name" validate:"required,max=100"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,oneof=read write"`
+}
+
+// APIKeyIssued carries the plain key, which is shown only once.
+type APIKeyIssued struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+type Calendar struct {
+	ID        uint   `json:"id"`
+	OwnerID   int    `json:"owner_id"`
+	Name      string `json:"name"`
+	IsDefault bool   `json:"default"`
+	Role      string `json:"role"`
+}
+
+type CalendarCreate struct {
+	UserID int    `json:"-" validate:"required"`
+	Name   string `json:"name" validate:"required,max=100"`
+}
+
+type CalendarMember struct {
+	CalendarID uint   `json:"-" validate:"required"`
+	UserID     int    `json:"user_id" validate:"required,gt=0"`
+	Role       string `json:"role" validate:"required,oneof=owner editor viewer freebusy"`
+}
+
+type Attendee struct {
+	UserID int    `json:"user_id"`
+	Status string `json:"status"`
+}
+
+type EventInvite struct {
+	EventID uint  `json:"-" validate:"required"`
+	UserID  int   `json:"-" validate:"required"`
+	UserIDs []int `json:"user_ids" validate:"required,min=1,max=100,dive,gt=0"`
+}
+
+type EventRSVP struct {
+	EventID uint   `json:"-" validate:"required"`
+	UserID  int    `json:"-" validate:"required"`
+	Status  string `json:"status" validate:"required,oneof=accepted declined tentative"`
+}
+
+type Tag struct {
+	ID     uint   `json:"id"`
+	UserID int    `json:"-"`
+	Name   string `json:"name" validate:"required,max=50"`
+	Color  string `json:"color,omitempty" validate:"omitempty,hexcolor"`
+}
+
+// EventTags replaces the user's tags on the event.
+type EventTags struct {
+	EventID uint   `json:"-" validate:"required"`
+	UserID  int    `json:"-" validate:"required"`
+	TagIDs  []uint `json:"tag_ids" validate:"max=20,unique,dive,gt=0"`
+}
+
+type TagCount struct {
+	TagID uint   `json:"tag_id"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type FreeBusyQuery struct {
+	UserID   int       `json:"-"`
+	UserIDs  []int     `json:"user_ids" validate:"required,min=1,max=50,unique,dive,gt=0"`
+	DateFrom time.Time `json:"date_from"`
+	DateTo   time.Time `json:"date_to"`
+}
+
+type BusyInterval struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+type FreeBusy struct {
+	UserID int            `json:"user_id"`
+	Busy   []BusyInterval `json:"busy"`
+}
+
+// SlotQuery asks for meeting slots of Duration minutes within [DateFrom,
+// DateTo). Candidate slots start every Step minutes.
+type SlotQuery struct {
+	UserID       int           `json:"-"`
+	UserIDs      []int         `json:"user_ids" validate:"required,min=1,max=50,unique,dive,gt=0"`
+	Duration     int           `json:"duration" validate:"required,gt=0,lte=1440"`
+	DateFrom     time.Time     `json:"from" validate:"required"`
+	DateTo       time.Time     `json:"to" validate:"required,gtfield=DateFrom"`
+	TimeZone     string        `json:"time_zone" validate:"omitempty,timezone"`
+	WorkingHours *WorkingHours `json:"working_hours"`
+	Step         int           `json:"step" validate:"omitempty,gt=0,lte=1440"`
+	Limit        int           `json:"limit" validate:"omitempty,gt=0,lte=50"`
+	Rank         string        `json:"rank" validate:"omitempty,oneof=earliest fewest_conflicts"`
+}
+
+type WorkingHours struct {
+	Start string   `json:"start" validate:"required,datetime=15:04"`
+	End   string   `json:"end" validate:"required,datetime=15:04"`
+	Days  []string `json:"days" validate:"omitempty,dive,oneof=monday tuesday wednesday thursday friday saturday sunday"`
+}
+
+type Slot struct {
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	BusyUserIDs []int     `json:"busy_user_ids"`
+}
+
+// UserSettings holds the user's availability rules. Users without working
+// hours are available around the clock.
+type UserSettings struct {
+	UserID       int            `json:"-"`
+	TimeZone     string         `json:"time_zone" validate:"required,timezone"`
+	WorkingHours []WorkingHours `json:"working_hours" validate:"max=50,dive"`
+	OutOfOffice  []*OutOfOffice `json:"out_of_office,omitempty" validate:"-"`
+}
+
+type OutOfOffice struct {
+	ID     uint      `json:"id"`
+	UserID int       `json:"-"`
+	Start  time.Time `json:"start" validate:"required"`
+	End    time.Time `json:"end" validate:"required,gtfield=Start"`
+	Reason string    `json:"reason,omitempty" validate:"max=200"`
+}
+
+// Availability is what scheduling needs to know about a user: when they work
+// and when they are away.
+type Availability struct {
+	UserID       int
+	TimeZone     string
+	WorkingHours []WorkingHours
+	OutOfOffice  []OutOfOffice
 }
@@ -0,0 +1,457 @@
+package ical
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/rrule"
+)
+
+const (
+	localLayout = "20060102T150405"
+
+	maxReminders      = 10
+	maxReminderMinute = 7 * 24 * 60
+)
+
+var (
+	ErrInvalidCalendar = errors.New("invalid calendar")
+	ErrCancelled       = errors.New("event is cancelled")
+)
+
+// Item is one VEVENT of an imported calendar. Err is set when the VEVENT
+// could not be mapped onto an event; UID is always filled so the item can be
+// reported.
+type Item struct {
+	UID   string
+	Event *models.EventCreate
+	Err   error
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+type component struct {
+	props  map[string][]*property
+	alarms []map[string][]*property
+}
+
+func (c *component) first(name string) *property {
+	if props := c.props[name]; len(props) > 0 {
+		return props[0]
+	}
+
+	return nil
+}
+
+// Decode parses the VEVENTs of an RFC 5545 calendar. Overridden occurrences
+// (VEVENTs with RECURRENCE-ID) become standalone events and are excluded from
+// their series.
+func Decode(r io.Reader) ([]*Item, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events   []*component
+		current  *component
+		alarm    map[string][]*property
+		calendar bool
+	)
+	for _, line := range lines {
+		p, err := parseLine(line)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VCALENDAR"):
+			calendar = true
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VEVENT"):
+			current = &component{props: map[string][]*property{}}
+		case p.name == "END" && strings.EqualFold(p.value, "VEVENT") && current != nil:
+			events = append(events, current)
+			current = nil
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VALARM") && current != nil:
+			alarm = map[string][]*property{}
+		case p.name == "END" && strings.EqualFold(p.value, "VALARM") && alarm != nil:
+			current.alarms = append(current.alarms, alarm)
+			alarm = nil
+		case alarm != nil:
+			alarm[p.name] = append(alarm[p.name], p)
+		case current != nil:
+			current.props[p.name] = append(current.props[p.name], p)
+		}
+	}
+
+	if !calendar {
+		return nil, fmt.Errorf("%w: missing VCALENDAR", ErrInvalidCalendar)
+	}
+
+	items := make([]*Item, 0, len(events))
+	for _, c := range events {
+		items = append(items, decodeEvent(c))
+	}
+	detachOverrides(items, events)
+
+	return items, nil
+}
+
+// detachOverrides turns VEVENTs that override one occurrence of a series into
+// standalone events with their own UID and excludes that occurrence from the
+// series.
+func detachOverrides(items []*Item, events []*component) {
+	masters := map[string]*Item{}
+	for i, c := range events {
+		if c.first("RECURRENCE-ID") == nil && items[i].Err == nil {
+			masters[items[i].UID] = items[i]
+		}
+	}
+
+	for i, c := range events {
+		p := c.first("RECURRENCE-ID")
+		if p == nil {
+			continue
+		}
+
+		recurrenceID, _, _, err := parseDateTime(p)
+		if err != nil {
+			items[i].Err = fmt.Errorf("invalid RECURRENCE-ID: %w", err)
+			continue
+		}
+
+		if master, ok := masters[items[i].UID]; ok && master.Event.RRule != "" {
+			master.Event.ExDates = append(master.Event.ExDates, recurrenceID)
+		}
+
+		items[i].UID = fmt.Sprintf("%s_%s", items[i].UID, recurrenceID.UTC().Format(utcLayout))
+		if items[i].Event != nil {
+			items[i].Event.UID = items[i].UID
+			items[i].Event.RRule = ""
+		}
+	}
+}
+
+func decodeEvent(c *component) *Item {
+	item := &Item{UID: eventUID(c)}
+
+	event, err := eventFromComponent(c)
+	if err != nil {
+		item.Err = err
+		return item
+	}
+
+	event.UID = item.UID
+	item.Event = event
+
+	return item
+}
+
+func eventFromComponent(c *component) (*models.EventCreate, error) {
+	if p := c.first("STATUS"); p != nil && strings.EqualFold(p.value, "CANCELLED") {
+		return nil, ErrCancelled
+	}
+
+	start := c.first("DTSTART")
+	if start == nil {
+		return nil, errors.New("missing DTSTART")
+	}
+
+	date, allDay, timeZone, err := parseDateTime(start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DTSTART: %w", err)
+	}
+
+	event := &models.EventCreate{
+		Date:     date,
+		AllDay:   allDay,
+		TimeZone: timeZone,
+	}
+
+	if p := c.first("SUMMARY"); p != nil {
+		event.Title = UnescapeText(p.value)
+	}
+	if event.Title == "" {
+		event.Title = "(no title)"
+	}
+	if p := c.first("DESCRIPTION"); p != nil {
+		event.Description = UnescapeText(p.value)
+	}
+	if p := c.first("LOCATION"); p != nil {
+		event.Location = UnescapeText(p.value)
+	}
+	// Only web links are kept; other URIs, such as mailto:, have nowhere to go.
+	if p := c.first("URL"); p != nil && isWebURL(p.value) {
+		event.URL = p.value
+	}
+
+	if p := c.first("DTEND"); p != nil {
+		event.EndDate, _, _, err = parseDateTime(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DTEND: %w", err)
+		}
+	} else if p := c.first("DURATION"); p != nil {
+		d, err := parseDuration(p.value)
+		if err != nil || d < 0 {
+			return nil, fmt.Errorf("invalid DURATION %q", p.value)
+		}
+		event.EndDate = date.Add(d)
+	}
+
+	if p := c.first("RRULE"); p != nil {
+		rule, err := rrule.Parse(p.value)
+		if err != nil {
+			return nil, err
+		}
+		event.RRule = rule.String()
+	}
+
+	for _, p := range c.props["EXDATE"] {
+		for _, value := range strings.Split(p.value, ",") {
+			exDate, _, _, err := parseDateTime(&property{params: p.params, value: value})
+			if err != nil {
+				return nil, fmt.Errorf("invalid EXDATE: %w", err)
+			}
+			event.ExDates = append(event.ExDates, exDate)
+		}
+	}
+
+	event.Reminders = reminders(c.alarms)
+
+	return event, nil
+}
+
+func isWebURL(s string) bool {
+	s = strings.ToLower(s)
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+// eventUID returns the UID of the VEVENT. Calendars that omit it get a UID
+// derived from the start and summary, so re-importing them is still deduped.
+func eventUID(c *component) string {
+	if p := c.first("UID"); p != nil && p.value != "" {
+		return p.value
+	}
+
+	h := sha1.New()
+	for _, name := range []string{"DTSTART", "SUMMARY"} {
+		if p := c.first(name); p != nil {
+			h.Write([]byte(p.value))
+		}
+		h.Write([]byte{0})
+	}
+
+	return "generated-" + hex.EncodeToString(h.Sum(nil))
+}
+
+// reminders maps display alarms that trigger before the start onto minutes
+// before the event; other alarms have no equivalent and are dropped.
+func reminders(alarms []map[string][]*property) []int {
+	var minutes []int
+	for _, alarm := range alarms {
+		triggers := alarm["TRIGGER"]
+		if len(triggers) == 0 || len(minutes) == maxReminders {
+			continue
+		}
+
+		trigger := triggers[0]
+		if strings.EqualFold(trigger.params["VALUE"], "DATE-TIME") || strings.EqualFold(trigger.params["RELATED"], "END") {
+			continue
+		}
+
+		d, err := parseDuration(trigger.value)
+		if err != nil || d >= 0 {
+			continue
+		}
+
+		m := int(-d / time.Minute)
+		if m > 0 && m <= maxReminderMinute {
+			minutes = append(minutes, m)
+		}
+	}
+
+	return minutes
+}
+
+// parseDateTime parses a DATE or DATE-TIME value. It reports whether the value
+// is a date and the IANA zone the value was given in.
+func parseDateTime(p *property) (time.Time, bool, string, error) {
+	value := strings.TrimSpace(p.value)
+
+	if strings.EqualFold(p.params["VALUE"], "DATE") || len(value) == len(dateLayout) {
+		t, err := time.Parse(dateLayout, value)
+		return t, true, "", err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(utcLayout, value)
+		return t, false, time.UTC.String(), err
+	}
+
+	loc := time.UTC
+	if tzid := strings.Trim(p.params["TZID"], "/"); tzid != "" {
+		var err error
+		loc, err = time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, false, "", fmt.Errorf("unknown time zone %q", tzid)
+		}
+	}
+
+	t, err := time.ParseInLocation(localLayout, value, loc)
+	return t, false, loc.String(), err
+}
+
+// parseDuration parses an RFC 5545 DURATION such as -PT15M or P1DT2H.
+func parseDuration(s string) (time.Duration, error) {
+	sign := time.Duration(1)
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign, s = -1, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	var (
+		d      time.Duration
+		inTime bool
+		digits string
+	)
+	for _, ch := range s[1:] {
+		switch {
+		case ch >= '0' && ch <= '9':
+			digits += string(ch)
+			continue
+		case ch == 'T' && digits == "":
+			inTime = true
+			continue
+		}
+
+		n, err := strconv.Atoi(digits)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		digits = ""
+
+		switch {
+		case ch == 'W' && !inTime:
+			d += time.Duration(n) * 7 * 24 * time.Hour
+		case ch == 'D' && !inTime:
+			d += time.Duration(n) * 24 * time.Hour
+		case ch == 'H' && inTime:
+			d += time.Duration(n) * time.Hour
+		case ch == 'M' && inTime:
+			d += time.Duration(n) * time.Minute
+		case ch == 'S' && inTime:
+			d += time.Duration(n) * time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+	}
+	if digits != "" {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	return sign * d, nil
+}
+
+// UnescapeText reverses EscapeText.
+func UnescapeText(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			b.WriteByte(s[i])
+			continue
+		}
+
+		i++
+		switch s[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return b.String()
+}
+
+// unfold joins folded content lines and drops empty ones.
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCalendar, err)
+	}
+
+	return lines, nil
+}
+
+// parseLine splits a content line into its name, parameters and value.
+// Parameter values may be quoted and contain ':' or ';'.
+func parseLine(line string) (*property, error) {
+	var (
+		parts  []string
+		quoted bool
+		start  int
+		value  = -1
+	)
+	for i := 0; i < len(line) && value < 0; i++ {
+		switch line[i] {
+		case '"':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				parts = append(parts, line[start:i])
+				start = i + 1
+			}
+		case ':':
+			if !quoted {
+				parts = append(parts, line[start:i])
+				value = i + 1
+			}
+		}
+	}
+	if value < 0 {
+		return nil, fmt.Errorf("%w: malformed line %q", ErrInvalidCalendar, line)
+	}
+
+	p := &property{
+		name:   strings.ToUpper(parts[0]),
+		params: map[string]string{},
+		value:  line[value:],
+	}
+	for _, param := range parts[1:] {
+		key, val, _ := strings.Cut(param, "=")
+		p.params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,148 @@
+//go:build unit
+// +build unit
+
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+const sample = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Example//EN\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:standup@example.com\r\n" +
+	"DTSTART;TZID=Europe/Berlin:20260105T090000\r\n" +
+	"DURATION:PT15M\r\n" +
+	"RRULE:FREQ=WEEKLY;WKST=MO;BYDAY=MO,WE\r\n" +
+	"EXDATE;TZID=Europe/Berlin:20260107T090000,20260112T090000\r\n" +
+	"SUMMARY:Standup\\, team \\;\r\n" +
+	"  room 4\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER:-PT10M\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:standup@example.com\r\n" +
+	"RECURRENCE-ID;TZID=Europe/Berlin:20260114T090000\r\n" +
+	"DTSTART;TZID=Europe/Berlin:20260114T100000\r\n" +
+	"DTEND;TZID=Europe/Berlin:20260114T101500\r\n" +
+	"SUMMARY:Standup (moved)\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:holiday@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20260107\r\n" +
+	"DTEND;VALUE=DATE:20260108\r\n" +
+	"SUMMARY:Holiday\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:broken@example.com\r\n" +
+	"DTSTART;TZID=Mars/Olympus:20260105T090000\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestDecode(t *testing.T) {
+	items, err := Decode(strings.NewReader(sample))
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	standup := items[0]
+	require.NoError(t, standup.Err)
+	assert.Equal(t, "standup@example.com", standup.Event.UID)
+	assert.Equal(t, "Standup, team ; room 4", standup.Event.Title)
+	assert.True(t, standup.Event.Date.Equal(time.Date(2026, 1, 5, 9, 0, 0, 0, berlin)))
+	assert.Equal(t, 15*time.Minute, standup.Event.EndDate.Sub(standup.Event.Date))
+	assert.Equal(t, "Europe/Berlin", standup.Event.TimeZone)
+	assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO,WE", standup.Event.RRule)
+	assert.Equal(t, []int{10}, standup.Event.Reminders)
+	require.Len(t, standup.Event.ExDates, 3)
+	assert.True(t, standup.Event.ExDates[2].Equal(time.Date(2026, 1, 14, 9, 0, 0, 0, berlin)))
+
+	moved := items[1]
+	require.NoError(t, moved.Err)
+	assert.Equal(t, "standup@example.com_20260114T080000Z", moved.UID)
+	assert.Equal(t, moved.UID, moved.Event.UID)
+	assert.Empty(t, moved.Event.RRule)
+
+	holiday := items[2]
+	require.NoError(t, holiday.Err)
+	assert.True(t, holiday.Event.AllDay)
+	assert.Equal(t, time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC), holiday.Event.Date)
+
+	assert.Error(t, items[3].Err)
+	assert.Equal(t, "broken@example.com", items[3].UID)
+}
+
+func TestDecodeRoundTrip(t *testing.T) {
+	date := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	text := strings.Repeat("Line, with; \\ specials\n", 5)
+
+	var b strings.Builder
+	require.NoError(t, Encode(&b, []*models.Event{{ID: 1, Title: text, Date: date, EndDate: date}}, date))
+
+	items, err := Decode(strings.NewReader(b.String()))
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.NoError(t, items[0].Err)
+	assert.Equal(t, text, items[0].Event.Title)
+	assert.Equal(t, "event-1@calendar-service", items[0].UID)
+}
+
+func TestDecodeDetailsRoundTrip(t *testing.T) {
+	date := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	event := &models.Event{ID: 1, Title: "Review", Description: "Agenda:\n1. Budget, Q1", Location: "Room 4; floor 2",
+		URL: "https://example.com/meet?id=1", Date: date, EndDate: date.Add(time.Hour)}
+
+	var b strings.Builder
+	require.NoError(t, Encode(&b, []*models.Event{event}, date))
+
+	items, err := Decode(strings.NewReader(b.String()))
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.NoError(t, items[0].Err)
+	assert.Equal(t, event.Description, items[0].Event.Description)
+	assert.Equal(t, event.Location, items[0].Event.Location)
+	assert.Equal(t, event.URL, items[0].Event.URL)
+}
+
+func TestDecodeSkipsNonWebURL(t *testing.T) {
+	items, err := Decode(strings.NewReader("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a@example.com\r\n" +
+		"DTSTART:20260105T090000Z\r\nSUMMARY:Call\r\nURL:mailto:team@example.com\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"))
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.NoError(t, items[0].Err)
+	assert.Empty(t, items[0].Event.URL)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	_, err := Decode(strings.NewReader("not a calendar"))
+	assert.ErrorIs(t, err, ErrInvalidCalendar)
+}
+
+func TestParseDuration(t *testing.T) {
+	for s, want := range map[string]time.Duration{
+		"PT15M":    15 * time.Minute,
+		"-PT1H30M": -90 * time.Minute,
+		"P1DT2H":   26 * time.Hour,
+		"P1W":      7 * 24 * time.Hour,
+	} {
+		got, err := parseDuration(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, want, got, s)
+	}
+
+	for _, s := range []string{"", "P", "PT", "P1H", "PT1D", "PT5"} {
+		_, err := parseDuration(s)
+		assert.Error(t, err, s)
+	}
+}
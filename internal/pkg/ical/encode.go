@@ -0,0 +1,206 @@
+package ical
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+const (
+	prodID     = "-//calendar-service//calendar-service//EN"
+	uidDomain  = "calendar-service"
+	dateLayout = "20060102"
+	utcLayout  = "20060102T150405Z"
+
+	// maxLineOctets is the longest content line RFC 5545 allows before folding.
+	maxLineOctets = 75
+)
+
+// UID returns the stable identifier of an event: the UID it was imported
+// with, or one derived from its ID. Occurrences of a recurring event are
+// exported separately, so their original start is part of the UID.
+func UID(e *models.Event) string {
+	if e.RecurrenceID != nil {
+		return fmt.Sprintf("event-%d-%s@%s", e.ID, e.RecurrenceID.UTC().Format(utcLayout), uidDomain)
+	}
+	if e.UID != "" {
+		return e.UID
+	}
+
+	return fmt.Sprintf("event-%d@%s", e.ID, uidDomain)
+}
+
+// ParseUID returns the event ID encoded in a UID generated by UID.
+func ParseUID(uid string) (uint, bool) {
+	var ID uint
+	if _, err := fmt.Sscanf(uid, "event-%d@"+uidDomain, &ID); err != nil || UID(&models.Event{ID: ID}) != uid {
+		return 0, false
+	}
+
+	return ID, true
+}
+
+// Encode writes events as an RFC 5545 VCALENDAR. stamp becomes the DTSTAMP
+// of every VEVENT.
+func Encode(w io.Writer, events []*models.Event, stamp time.Time) error {
+	var b strings.Builder
+
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:"+prodID)
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+
+	// Every zone named by a TZID needs a VTIMEZONE, described from the year
+	// before its first use on.
+	var zones []*time.Location
+	years := make(map[string]int)
+	for _, e := range events {
+		loc := location(e)
+		if loc == time.UTC {
+			continue
+		}
+
+		year, ok := years[loc.String()]
+		if !ok {
+			zones = append(zones, loc)
+		}
+		if first := firstYear(e, loc); !ok || first < year {
+			years[loc.String()] = first
+		}
+	}
+	for _, loc := range zones {
+		writeTimeZone(&b, loc, years[loc.String()]-1)
+	}
+
+	for _, e := range events {
+		writeEvent(&b, e, stamp)
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeEvent(b *strings.Builder, e *models.Event, stamp time.Time) {
+	writeLine(b, "BEGIN:VEVENT")
+	writeLine(b, "UID:"+UID(e))
+	writeLine(b, "DTSTAMP:"+stamp.UTC().Format(utcLayout))
+
+	series := e.RRule != "" && e.RecurrenceID == nil
+	loc := location(e)
+
+	writeLine(b, "DTSTART"+formatTime(e.Date, e.AllDay, loc))
+	if e.EndDate.After(e.Date) {
+		writeLine(b, "DTEND"+formatTime(e.EndDate, e.AllDay, loc))
+	}
+
+	if series {
+		writeLine(b, "RRULE:"+e.RRule)
+		for _, ex := range e.ExDates {
+			writeLine(b, "EXDATE"+formatTime(ex, e.AllDay, loc))
+		}
+	}
+
+	writeLine(b, "SUMMARY:"+EscapeText(e.Title))
+	if e.Description != "" {
+		writeLine(b, "DESCRIPTION:"+EscapeText(e.Description))
+	}
+	if e.Location != "" {
+		writeLine(b, "LOCATION:"+EscapeText(e.Location))
+	}
+	if e.URL != "" {
+		writeLine(b, "URL:"+e.URL)
+	}
+
+	for _, minutes := range e.Reminders {
+		writeLine(b, "BEGIN:VALARM")
+		writeLine(b, "ACTION:DISPLAY")
+		writeLine(b, fmt.Sprintf("TRIGGER:-PT%dM", minutes))
+		writeLine(b, "DESCRIPTION:"+EscapeText(e.Title))
+		writeLine(b, "END:VALARM")
+	}
+
+	writeLine(b, "END:VEVENT")
+}
+
+// location returns the zone the event's times are written in. A series is
+// expanded by the client, so its times are written as wall clock in the
+// event's zone to keep occurrences stable across DST; anything else, and
+// all-day events, are written in UTC.
+func location(e *models.Event) *time.Location {
+	if e.RRule == "" || e.RecurrenceID != nil || e.AllDay || e.TimeZone == "" {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(e.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// firstYear returns the earliest year, in loc, of the times written for e.
+func firstYear(e *models.Event, loc *time.Location) int {
+	year := e.Date.In(loc).Year()
+	for _, ex := range e.ExDates {
+		if y := ex.In(loc).Year(); y < year {
+			year = y
+		}
+	}
+
+	return year
+}
+
+// formatTime returns the parameters and value of a DATE or DATE-TIME property.
+func formatTime(t time.Time, allDay bool, loc *time.Location) string {
+	switch {
+	case allDay:
+		return ";VALUE=DATE:" + t.Format(dateLayout)
+	case loc == time.UTC:
+		return ":" + t.UTC().Format(utcLayout)
+	default:
+		return ";TZID=" + loc.String() + ":" + t.In(loc).Format(localLayout)
+	}
+}
+
+// EscapeText escapes a TEXT value as required by RFC 5545 section 3.3.11.
+func EscapeText(s string) string {
+	r := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+
+	return r.Replace(s)
+}
+
+// writeLine folds a content line into chunks of at most 75 octets without
+// splitting UTF-8 sequences and terminates every physical line with CRLF.
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines lose one octet to the leading space
+		limit = maxLineOctets - 1
+	}
+
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
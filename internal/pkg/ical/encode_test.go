@@ -0,0 +1,140 @@
+//go:build unit
+// +build unit
+
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func TestEncode(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	recurrenceID := start
+	stamp := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	events := []*models.Event{
+		{ID: 7, Title: "Standup; daily, with team\nroom \\ 4", Date: start, EndDate: start.Add(15 * time.Minute),
+			Reminders: []int{10}, RecurrenceID: &recurrenceID},
+		{ID: 8, Title: "Holiday", Date: time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC),
+			EndDate: time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC), AllDay: true},
+	}
+
+	var b strings.Builder
+	require.NoError(t, Encode(&b, events, stamp))
+	out := b.String()
+
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+	assert.Contains(t, out, "UID:event-7-20260105T090000Z@calendar-service\r\n")
+	assert.Contains(t, out, "DTSTAMP:20260101T120000Z\r\n")
+	assert.Contains(t, out, "DTSTART:20260105T090000Z\r\nDTEND:20260105T091500Z\r\n")
+	assert.Contains(t, out, `SUMMARY:Standup\; daily\, with team\nroom \\ 4`)
+	assert.Contains(t, out, "TRIGGER:-PT10M\r\n")
+	assert.Contains(t, out, "UID:event-8@calendar-service\r\n")
+	assert.Contains(t, out, "DTSTART;VALUE=DATE:20260107\r\nDTEND;VALUE=DATE:20260108\r\n")
+}
+
+func TestEncodeFoldsLongLines(t *testing.T) {
+	date := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	events := []*models.Event{
+		{ID: 1, Title: strings.Repeat("Встреча ", 30), Date: date, EndDate: date},
+	}
+
+	var b strings.Builder
+	require.NoError(t, Encode(&b, events, date))
+
+	for _, line := range strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+		assert.True(t, strings.ToValidUTF8(line, "") == line, "line splits a UTF-8 sequence: %q", line)
+	}
+	assert.Contains(t, b.String(), "\r\n ")
+}
+
+func TestEncodeSeries(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, berlin)
+	events := []*models.Event{
+		{ID: 3, UID: "standup@example.com", Title: "Standup", Date: start, EndDate: start.Add(15 * time.Minute),
+			TimeZone: "Europe/Berlin", RRule: "FREQ=WEEKLY;BYDAY=MO", ExDates: []time.Time{start.AddDate(0, 0, 7)}},
+	}
+
+	var b strings.Builder
+	require.NoError(t, Encode(&b, events, start))
+	out := b.String()
+
+	assert.Contains(t, out, "UID:standup@example.com\r\n")
+	assert.Contains(t, out, "DTSTART;TZID=Europe/Berlin:20260105T090000\r\n")
+	assert.Contains(t, out, "RRULE:FREQ=WEEKLY;BYDAY=MO\r\n")
+	assert.Contains(t, out, "EXDATE;TZID=Europe/Berlin:20260112T090000\r\n")
+	assert.Contains(t, out, "BEGIN:VTIMEZONE\r\nTZID:Europe/Berlin\r\n")
+
+	items, err := Decode(strings.NewReader(out))
+	require.NoError(t, err)
+	require.NoError(t, items[0].Err)
+	assert.True(t, items[0].Event.Date.Equal(start))
+	assert.Equal(t, "Europe/Berlin", items[0].Event.TimeZone)
+}
+
+func TestEncodeTimeZones(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, newYork)
+	events := []*models.Event{
+		{ID: 1, Title: "Standup", Date: start, EndDate: start.Add(15 * time.Minute), TimeZone: "America/New_York",
+			RRule: "FREQ=DAILY"},
+		{ID: 2, Title: "Sync", Date: start.In(tokyo), EndDate: start.Add(time.Hour), TimeZone: "Asia/Tokyo",
+			RRule: "FREQ=WEEKLY"},
+		{ID: 3, Title: "Retro", Date: start, EndDate: start.Add(time.Hour), TimeZone: "America/New_York",
+			RRule: "FREQ=MONTHLY"},
+		{ID: 4, Title: "Single", Date: start, EndDate: start.Add(time.Hour), TimeZone: "Europe/Paris"},
+	}
+
+	var b strings.Builder
+	require.NoError(t, Encode(&b, events, start))
+	out := b.String()
+
+	assert.Equal(t, 1, strings.Count(out, "TZID:America/New_York\r\n"))
+	assert.Contains(t, out, "BEGIN:VTIMEZONE\r\nTZID:America/New_York\r\n"+
+		"BEGIN:DAYLIGHT\r\nDTSTART:20250309T020000\r\nRRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=2SU\r\n"+
+		"TZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\nTZNAME:EDT\r\nEND:DAYLIGHT\r\n"+
+		"BEGIN:STANDARD\r\nDTSTART:20251102T020000\r\nRRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=1SU\r\n"+
+		"TZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\nTZNAME:EST\r\nEND:STANDARD\r\nEND:VTIMEZONE\r\n")
+	assert.Contains(t, out, "BEGIN:VTIMEZONE\r\nTZID:Asia/Tokyo\r\n"+
+		"BEGIN:STANDARD\r\nDTSTART:19700101T000000\r\nTZOFFSETFROM:+0900\r\nTZOFFSETTO:+0900\r\n")
+	assert.NotContains(t, out, "TZID:Europe/Paris")
+
+	// Every TZID used by a property has its VTIMEZONE.
+	for _, tzid := range []string{"America/New_York", "Asia/Tokyo"} {
+		assert.Contains(t, out, "DTSTART;TZID="+tzid+":")
+		assert.Contains(t, out, "BEGIN:VTIMEZONE\r\nTZID:"+tzid+"\r\n")
+	}
+
+	items, err := Decode(strings.NewReader(out))
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+	assert.True(t, items[0].Event.Date.Equal(start))
+}
+
+func TestFormatOffset(t *testing.T) {
+	assert.Equal(t, "+0530", formatOffset(5*3600+30*60))
+	assert.Equal(t, "-0345", formatOffset(-(3*3600 + 45*60)))
+	assert.Equal(t, "+002030", formatOffset(20*60+30))
+}
+
+func TestParseUID(t *testing.T) {
+	ID, ok := ParseUID("event-42@calendar-service")
+	assert.True(t, ok)
+	assert.Equal(t, uint(42), ID)
+
+	for _, uid := range []string{"event-42@example.com", "event-42-20260105T090000Z@calendar-service", "x"} {
+		_, ok = ParseUID(uid)
+		assert.False(t, ok, uid)
+	}
+}
@@ -0,0 +1,119 @@
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// writeTimeZone writes the VTIMEZONE that RFC 5545 requires for every TZID
+// used. The zone is described by the rules in effect in year: a single
+// STANDARD observance for zones without daylight saving time, otherwise
+// STANDARD and DAYLIGHT observances recurring on the weekday of that year's
+// transitions. Callers pass a year before the zone's first use, so every
+// time written with the TZID is covered.
+func writeTimeZone(b *strings.Builder, loc *time.Location, year int) {
+	writeLine(b, "BEGIN:VTIMEZONE")
+	writeLine(b, "TZID:"+loc.String())
+
+	transitions := yearTransitions(loc, year)
+	if len(transitions) != 2 {
+		at := time.Date(year+1, 1, 1, 0, 0, 0, 0, loc)
+		name, offset := at.Zone()
+		writeLine(b, "BEGIN:STANDARD")
+		writeLine(b, "DTSTART:19700101T000000")
+		writeLine(b, "TZOFFSETFROM:"+formatOffset(offset))
+		writeLine(b, "TZOFFSETTO:"+formatOffset(offset))
+		writeLine(b, "TZNAME:"+name)
+		writeLine(b, "END:STANDARD")
+	} else {
+		for _, t := range transitions {
+			writeObservance(b, t, loc)
+		}
+	}
+
+	writeLine(b, "END:VTIMEZONE")
+}
+
+// writeObservance writes the observance that starts at the transition t.
+func writeObservance(b *strings.Builder, t time.Time, loc *time.Location) {
+	kind := "STANDARD"
+	if t.In(loc).IsDST() {
+		kind = "DAYLIGHT"
+	}
+
+	_, from := t.Add(-time.Second).In(loc).Zone()
+	name, to := t.In(loc).Zone()
+	// DTSTART is the wall clock time of the transition before it happens.
+	start := t.UTC().Add(time.Duration(from) * time.Second)
+
+	writeLine(b, "BEGIN:"+kind)
+	writeLine(b, "DTSTART:"+start.Format(localLayout))
+	writeLine(b, "RRULE:"+yearlyRule(start))
+	writeLine(b, "TZOFFSETFROM:"+formatOffset(from))
+	writeLine(b, "TZOFFSETTO:"+formatOffset(to))
+	writeLine(b, "TZNAME:"+name)
+	writeLine(b, "END:"+kind)
+}
+
+// yearTransitions returns the instants within year at which the offset of loc
+// changes.
+func yearTransitions(loc *time.Location, year int) []time.Time {
+	var transitions []time.Time
+
+	day := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := day.AddDate(1, 0, 0)
+	for ; day.Before(end); day = day.AddDate(0, 0, 1) {
+		next := day.AddDate(0, 0, 1)
+		if offset(day, loc) == offset(next, loc) {
+			continue
+		}
+
+		// The offset changes within the day: narrow it down to the second.
+		lo, hi := day, next
+		for hi.Sub(lo) > time.Second {
+			mid := lo.Add(hi.Sub(lo) / 2)
+			if offset(mid, loc) == offset(lo, loc) {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		transitions = append(transitions, hi)
+	}
+
+	return transitions
+}
+
+func offset(t time.Time, loc *time.Location) int {
+	_, o := t.In(loc).Zone()
+	return o
+}
+
+// yearlyRule returns the RRULE repeating t every year on the same weekday of
+// the same week of its month, the last week being counted from the end.
+func yearlyRule(t time.Time) string {
+	week := (t.Day()-1)/7 + 1
+	if t.Day()+7 > time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+		week = -1
+	}
+	day := strings.ToUpper(t.Weekday().String()[:2])
+
+	return fmt.Sprintf("FREQ=YEARLY;BYMONTH=%d;BYDAY=%d%s", int(t.Month()), week, day)
+}
+
+// formatOffset formats a UTC offset in seconds as a UTC-OFFSET value.
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+
+	s := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		s += fmt.Sprintf("%02d", seconds%60)
+	}
+
+	return s
+}
@@ -0,0 +1,31 @@
+package notifier
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+type Log struct {
+	logger *zap.Logger
+}
+
+func NewLog(l *zap.Logger) *Log {
+	return &Log{
+		logger: l,
+	}
+}
+
+func (n *Log) Notify(_ context.Context, notification *models.Notification) error {
+	n.logger.Info("reminder",
+		zap.Uint("event_id", notification.EventID),
+		zap.Int("user_id", notification.UserID),
+		zap.String("event", notification.Event),
+		zap.Time("date", notification.Date),
+		zap.Int("minutes_before", notification.MinutesBefore),
+	)
+
+	return nil
+}
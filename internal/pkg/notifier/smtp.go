@@ -0,0 +1,56 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+type SMTP struct {
+	addr            string
+	auth            smtp.Auth
+	from            string
+	recipientFormat string
+}
+
+// NewSMTP creates a notifier that mails reminders to the address produced by
+// formatting recipientFormat with the user id, e.g. "user%d@example.com".
+func NewSMTP(host, port, username, password, from, recipientFormat string) *SMTP {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTP{
+		addr:            net.JoinHostPort(host, port),
+		auth:            auth,
+		from:            from,
+		recipientFormat: recipientFormat,
+	}
+}
+
+func (n *SMTP) Notify(_ context.Context, notification *models.Notification) error {
+	to := fmt.Sprintf(n.recipientFormat, notification.UserID)
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", n.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: Reminder: %s\r\n", sanitizeHeader(notification.Event))
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%s starts at %s.\r\n", notification.Event, notification.Date.Format("2006-01-02 15:04 MST"))
+
+	err := smtp.SendMail(n.addr, n.auth, n.from, []string{to}, []byte(msg.String()))
+	if err != nil {
+		return fmt.Errorf("notifier/SMTP - %w", err)
+	}
+
+	return nil
+}
+
+func sanitizeHeader(s string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(s)
+}
@@ -0,0 +1,60 @@
+package period
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidWeekday = errors.New("invalid weekday")
+)
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+func ParseWeekday(s string) (time.Weekday, error) {
+	day, ok := weekdays[strings.ToLower(strings.TrimSpace(s))]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidWeekday, s)
+	}
+
+	return day, nil
+}
+
+// The window functions return the half-open interval [from, to) of the
+// calendar period containing t, computed in t's location.
+
+func Day(t time.Time) (time.Time, time.Time) {
+	from := startOfDay(t)
+	return from, from.AddDate(0, 0, 1)
+}
+
+func Week(t time.Time, weekStart time.Weekday) (time.Time, time.Time) {
+	offset := (int(t.Weekday()) - int(weekStart) + 7) % 7
+	from := startOfDay(t).AddDate(0, 0, -offset)
+	return from, from.AddDate(0, 0, 7)
+}
+
+func Month(t time.Time) (time.Time, time.Time) {
+	from := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	return from, from.AddDate(0, 1, 0)
+}
+
+func Year(t time.Time) (time.Time, time.Time) {
+	from := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+	return from, from.AddDate(1, 0, 0)
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,433 @@
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidRule = errors.New("invalid recurrence rule")
+)
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+// maxPeriods bounds the expansion loop for rules that never produce an occurrence.
+const maxPeriods = 100000
+
+const untilLayout = "20060102T150405Z"
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+type Weekday struct {
+	Day time.Weekday
+	N   int
+}
+
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	ByDay      []Weekday
+	ByMonthDay []int
+	Count      int
+	Until      time.Time
+}
+
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return nil, fmt.Errorf("%w: empty rule", ErrInvalidRule)
+	}
+
+	r := &Rule{Interval: 1}
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidRule, part)
+		}
+
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Freq = Frequency(strings.ToUpper(value))
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err == nil && r.Interval < 1 {
+				err = errors.New("interval must be positive")
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if err == nil && r.Count < 1 {
+				err = errors.New("count must be positive")
+			}
+		case "UNTIL":
+			r.Until, err = parseUntil(value)
+		case "BYDAY":
+			r.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseByMonthDay(value)
+		case "WKST":
+			if _, ok := weekdays[strings.ToUpper(value)]; !ok {
+				err = fmt.Errorf("unknown weekday %q", value)
+			}
+		default:
+			err = fmt.Errorf("unsupported part %q", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRule, err)
+		}
+	}
+
+	switch r.Freq {
+	case Daily, Weekly, Monthly, Yearly:
+	default:
+		return nil, fmt.Errorf("%w: unsupported FREQ %q", ErrInvalidRule, r.Freq)
+	}
+
+	if r.Count > 0 && !r.Until.IsZero() {
+		return nil, fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", ErrInvalidRule)
+	}
+
+	return r, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse(untilLayout, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("20060102T150405", value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse("20060102", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid UNTIL %q", value)
+	}
+
+	// A date-only UNTIL includes the whole day.
+	return t.Add(24*time.Hour - time.Second), nil
+}
+
+func parseByDay(value string) ([]Weekday, error) {
+	var days []Weekday
+	for _, item := range strings.Split(value, ",") {
+		item = strings.ToUpper(strings.TrimSpace(item))
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid BYDAY %q", item)
+		}
+
+		day, ok := weekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid BYDAY %q", item)
+		}
+
+		var n int
+		if prefix := item[:len(item)-2]; prefix != "" {
+			var err error
+			n, err = strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("invalid BYDAY %q", item)
+			}
+		}
+
+		days = append(days, Weekday{Day: day, N: n})
+	}
+
+	return days, nil
+}
+
+func parseByMonthDay(value string) ([]int, error) {
+	var days []int
+	for _, item := range strings.Split(value, ",") {
+		day, err := strconv.Atoi(strings.TrimSpace(item))
+		if err != nil || day == 0 || day < -31 || day > 31 {
+			return nil, fmt.Errorf("invalid BYMONTHDAY %q", item)
+		}
+
+		days = append(days, day)
+	}
+
+	return days, nil
+}
+
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, d := range r.ByDay {
+			s := strings.ToUpper(d.Day.String()[:2])
+			if d.N != 0 {
+				s = strconv.Itoa(d.N) + s
+			}
+			days = append(days, s)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, 0, len(r.ByMonthDay))
+		for _, d := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(d))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilLayout))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Between returns the occurrence start times of the rule anchored at dtstart
+// that fall into [from, to]. Occurrences are computed in dtstart's location,
+// so the wall-clock time stays the same across DST transitions.
+func (r *Rule) Between(dtstart, from, to time.Time) []time.Time {
+	var result []time.Time
+
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	var count int
+	start := 0
+	if r.Count == 0 {
+		start = r.skipPeriods(dtstart, from)
+	}
+
+	for k := start; k < start+maxPeriods; k++ {
+		periodStart := r.periodStart(dtstart, k*interval)
+		if periodStart.After(to) || (!r.Until.IsZero() && periodStart.After(r.Until)) {
+			break
+		}
+
+		for _, t := range r.candidates(dtstart, periodStart) {
+			if t.Before(dtstart) {
+				continue
+			}
+			if !r.Until.IsZero() && t.After(r.Until) {
+				return result
+			}
+			if t.After(to) {
+				return result
+			}
+
+			count++
+			if !t.Before(from) {
+				result = append(result, t)
+			}
+			if r.Count > 0 && count >= r.Count {
+				return result
+			}
+		}
+	}
+
+	return result
+}
+
+// skipPeriods returns a period index safely before from, so rules without
+// COUNT do not have to be walked from the very first occurrence.
+func (r *Rule) skipPeriods(dtstart, from time.Time) int {
+	if !from.After(dtstart) {
+		return 0
+	}
+
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	var periods int
+	days := int(from.Sub(dtstart).Hours() / 24)
+	switch r.Freq {
+	case Daily:
+		periods = days / interval
+	case Weekly:
+		periods = days / 7 / interval
+	case Monthly:
+		periods = monthsBetween(dtstart, from) / interval
+	case Yearly:
+		periods = (from.Year() - dtstart.Year()) / interval
+	}
+
+	if periods > 1 {
+		return periods - 1
+	}
+
+	return 0
+}
+
+func monthsBetween(a, b time.Time) int {
+	return (b.Year()-a.Year())*12 + int(b.Month()) - int(a.Month())
+}
+
+func (r *Rule) periodStart(dtstart time.Time, n int) time.Time {
+	y, m, d := dtstart.Date()
+	loc := dtstart.Location()
+
+	switch r.Freq {
+	case Daily:
+		return time.Date(y, m, d+n, 0, 0, 0, 0, loc)
+	case Weekly:
+		offset := (int(dtstart.Weekday()) + 6) % 7
+		return time.Date(y, m, d-offset+7*n, 0, 0, 0, 0, loc)
+	case Monthly:
+		return time.Date(y, m+time.Month(n), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(y+n, time.January, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+func (r *Rule) candidates(dtstart, periodStart time.Time) []time.Time {
+	var days []time.Time
+
+	switch r.Freq {
+	case Daily:
+		days = []time.Time{periodStart}
+	case Weekly:
+		if len(r.ByDay) == 0 {
+			days = []time.Time{addDays(periodStart, (int(dtstart.Weekday())+6)%7)}
+			break
+		}
+		for i := 0; i < 7; i++ {
+			days = append(days, addDays(periodStart, i))
+		}
+	case Monthly:
+		days = r.monthDays(dtstart, periodStart)
+	case Yearly:
+		days = r.yearDays(dtstart, periodStart)
+	}
+
+	result := make([]time.Time, 0, len(days))
+	for _, day := range days {
+		if !r.matchDay(day) {
+			continue
+		}
+
+		y, m, d := day.Date()
+		result = append(result, time.Date(y, m, d,
+			dtstart.Hour(), dtstart.Minute(), dtstart.Second(), dtstart.Nanosecond(), dtstart.Location()))
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+
+	return result
+}
+
+func (r *Rule) monthDays(dtstart, monthStart time.Time) []time.Time {
+	if len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+		day := time.Date(monthStart.Year(), monthStart.Month(), dtstart.Day(), 0, 0, 0, 0, monthStart.Location())
+		if day.Month() != monthStart.Month() {
+			return nil
+		}
+		return []time.Time{day}
+	}
+
+	return daysOfRange(monthStart, monthStart.AddDate(0, 1, 0))
+}
+
+func (r *Rule) yearDays(dtstart, yearStart time.Time) []time.Time {
+	if len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+		day := time.Date(yearStart.Year(), dtstart.Month(), dtstart.Day(), 0, 0, 0, 0, yearStart.Location())
+		if day.Month() != dtstart.Month() {
+			return nil
+		}
+		return []time.Time{day}
+	}
+
+	return daysOfRange(yearStart, yearStart.AddDate(1, 0, 0))
+}
+
+// matchDay applies the BYDAY and BYMONTHDAY filters. Ordinal BYDAY values are
+// relative to the month for MONTHLY rules and to the year for YEARLY rules.
+func (r *Rule) matchDay(day time.Time) bool {
+	if len(r.ByMonthDay) > 0 {
+		lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location()).Day()
+		matched := false
+		for _, md := range r.ByMonthDay {
+			if md == day.Day() || (md < 0 && lastDay+md+1 == day.Day()) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if len(r.ByDay) == 0 {
+		return true
+	}
+
+	for _, wd := range r.ByDay {
+		if wd.Day != day.Weekday() {
+			continue
+		}
+		if wd.N == 0 || r.Freq == Daily || r.Freq == Weekly {
+			return true
+		}
+
+		var first, next time.Time
+		if r.Freq == Monthly {
+			first = time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+			next = first.AddDate(0, 1, 0)
+		} else {
+			first = time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, day.Location())
+			next = first.AddDate(1, 0, 0)
+		}
+
+		if wd.N > 0 && (day.YearDay()-first.YearDay())/7+1 == wd.N {
+			return true
+		}
+		if wd.N < 0 && -((lastYearDay(next)-day.YearDay())/7+1) == wd.N {
+			return true
+		}
+	}
+
+	return false
+}
+
+func lastYearDay(next time.Time) int {
+	return next.AddDate(0, 0, -1).YearDay()
+}
+
+func daysOfRange(from, to time.Time) []time.Time {
+	var days []time.Time
+	for d := from; d.Before(to); d = addDays(d, 1) {
+		days = append(days, d)
+	}
+
+	return days
+}
+
+func addDays(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d+n, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,90 @@
+//go:build unit
+// +build unit
+
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20260101T000000Z",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+	} {
+		_, err := Parse(s)
+		assert.ErrorIs(t, err, ErrInvalidRule, s)
+	}
+}
+
+func TestParseString(t *testing.T) {
+	r, err := Parse("RRULE:FREQ=MONTHLY;INTERVAL=2;BYDAY=-1FR,MO;COUNT=5")
+	require.NoError(t, err)
+	assert.Equal(t, "FREQ=MONTHLY;INTERVAL=2;BYDAY=-1FR,MO;COUNT=5", r.String())
+}
+
+func TestBetweenWeeklyByDay(t *testing.T) {
+	r, err := Parse("FREQ=WEEKLY;BYDAY=MO,WE;COUNT=4")
+	require.NoError(t, err)
+
+	dtstart := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	got := r.Between(dtstart, dtstart, dtstart.AddDate(1, 0, 0))
+
+	assert.Equal(t, []time.Time{
+		time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 7, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 12, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 14, 9, 0, 0, 0, time.UTC),
+	}, got)
+}
+
+func TestBetweenMonthlyLastFriday(t *testing.T) {
+	r, err := Parse("FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20260430T235959Z")
+	require.NoError(t, err)
+
+	dtstart := time.Date(2026, 1, 30, 15, 0, 0, 0, time.UTC)
+	got := r.Between(dtstart, dtstart, dtstart.AddDate(1, 0, 0))
+
+	assert.Equal(t, []time.Time{
+		time.Date(2026, 1, 30, 15, 0, 0, 0, time.UTC),
+		time.Date(2026, 2, 27, 15, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 27, 15, 0, 0, 0, time.UTC),
+		time.Date(2026, 4, 24, 15, 0, 0, 0, time.UTC),
+	}, got)
+}
+
+func TestBetweenMonthDaySkipsShortMonths(t *testing.T) {
+	r, err := Parse("FREQ=MONTHLY;BYMONTHDAY=31")
+	require.NoError(t, err)
+
+	dtstart := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	got := r.Between(dtstart, dtstart, time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, []time.Time{
+		time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC),
+	}, got)
+}
+
+func TestBetweenWindowFarFromStart(t *testing.T) {
+	r, err := Parse("FREQ=DAILY;INTERVAL=3")
+	require.NoError(t, err)
+
+	dtstart := time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	got := r.Between(dtstart, from, from.AddDate(0, 0, 7))
+
+	require.Len(t, got, 2)
+	for _, occ := range got {
+		assert.Equal(t, 0, int(occ.Sub(dtstart).Hours())%72)
+	}
+}
@@ -1,17 +1,29 @@
 package validator
 
-import "github.com/go-playground/validator/v10"
+import (
+	"github.com/go-playground/validator/v10"
+
+	"github.com/avraam311/calendar-service/internal/pkg/rrule"
+)
 
 type GoValidator struct {
 	validate *validator.Validate
 }
 
 func New() *GoValidator {
+	v := validator.New()
+	_ = v.RegisterValidation("rrule", validateRRule)
+
 	return &GoValidator{
-		validate: validator.New(),
+		validate: v,
 	}
 }
 
 func (v *GoValidator) Validate(i interface{}) error {
 	return v.validate.Struct(i)
 }
+
+func validateRRule(fl validator.FieldLevel) bool {
+	_, err := rrule.Parse(fl.Field().String())
+	return err == nil
+}
@@ -0,0 +1,144 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+var (
+	ErrKeyNotFound = errors.New("api key not found")
+)
+
+type DB interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, optionsAndArgs ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, optionsAndArgs ...any) pgx.Row
+}
+
+type Repository struct {
+	db DB
+}
+
+func New(db DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+func (r *Repository) CreateKey(ctx context.Context, key *models.APIKey, hash string) (*models.APIKey, error) {
+	query := `
+		INSERT INTO api_keys (user_id, name, prefix, key_hash, scopes)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at;
+    `
+
+	created := *key
+	err := r.db.QueryRow(ctx, query, key.UserID, key.Name, key.Prefix, hash, key.Scopes).
+		Scan(&created.ID, &created.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("repository/CreateKey - %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetKeys lists the user's keys, revoked ones included for auditing.
+func (r *Repository) GetKeys(ctx context.Context, userID int) ([]*models.APIKey, error) {
+	query := `
+		SELECT id, user_id, name, prefix, scopes, created_at, last_used_at, revoked_at
+		FROM api_keys
+		WHERE user_id = $1
+		ORDER BY id
+    `
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetKeys - %w", err)
+	}
+	defer rows.Close()
+
+	keys := []*models.APIKey{}
+	for rows.Next() {
+		var k models.APIKey
+		err := rows.Scan(&k.ID, &k.UserID, &k.Name, &k.Prefix, &k.Scopes, &k.CreatedAt, &k.LastUsedAt, &k.RevokedAt)
+		if err != nil {
+			return nil, fmt.Errorf("repository/GetKeys - %w", err)
+		}
+
+		keys = append(keys, &k)
+	}
+
+	return keys, nil
+}
+
+// RotateKey replaces the secret of an active key; the old secret stops
+// working immediately.
+func (r *Repository) RotateKey(ctx context.Context, userID int, ID uint, prefix, hash string) (*models.APIKey, error) {
+	query := `
+		UPDATE api_keys
+		SET prefix = $3, key_hash = $4, last_used_at = NULL
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+		RETURNING id, user_id, name, prefix, scopes, created_at;
+    `
+
+	var k models.APIKey
+	err := r.db.QueryRow(ctx, query, ID, userID, prefix, hash).
+		Scan(&k.ID, &k.UserID, &k.Name, &k.Prefix, &k.Scopes, &k.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrKeyNotFound
+		}
+
+		return nil, fmt.Errorf("repository/RotateKey - %w", err)
+	}
+
+	return &k, nil
+}
+
+func (r *Repository) RevokeKey(ctx context.Context, userID int, ID uint) error {
+	query := `
+		UPDATE api_keys
+		SET revoked_at = now()
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL;
+    `
+
+	cmdTag, err := r.db.Exec(ctx, query, ID, userID)
+	if err != nil {
+		return fmt.Errorf("repository/RevokeKey - %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrKeyNotFound
+	}
+
+	return nil
+}
+
+// UseKey resolves an active key by its hash and records the time it was used.
+func (r *Repository) UseKey(ctx context.Context, hash string) (*models.APIKey, error) {
+	query := `
+		UPDATE api_keys
+		SET last_used_at = now()
+		WHERE key_hash = $1 AND revoked_at IS NULL
+		RETURNING id, user_id, name, prefix, scopes, created_at, last_used_at;
+    `
+
+	var k models.APIKey
+	err := r.db.QueryRow(ctx, query, hash).
+		Scan(&k.ID, &k.UserID, &k.Name, &k.Prefix, &k.Scopes, &k.CreatedAt, &k.LastUsedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrKeyNotFound
+		}
+
+		return nil, fmt.Errorf("repository/UseKey - %w", err)
+	}
+
+	return &k, nil
+}
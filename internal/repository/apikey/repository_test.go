@@ -0,0 +1,83 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepositoryCreateKey(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	createdAt := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	key := &models.APIKey{UserID: 1, Name: "batch", Prefix: "cal_abcdefgh", Scopes: []string{"read"}}
+
+	mock.ExpectQuery("INSERT INTO api_keys").
+		WithArgs(1, "batch", "cal_abcdefgh", "hash", []string{"read"}).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created_at"}).AddRow(uint(3), createdAt))
+
+	created, err := repo.CreateKey(context.Background(), key, "hash")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3), created.ID)
+	assert.Equal(t, createdAt, created.CreatedAt)
+	assert.Equal(t, "batch", created.Name)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryUseKey(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	now := time.Now()
+	mock.ExpectQuery("UPDATE api_keys SET last_used_at").
+		WithArgs("hash").
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "name", "prefix", "scopes", "created_at", "last_used_at"}).
+			AddRow(uint(3), 1, "batch", "cal_abcdefgh", []string{"write"}, now, &now))
+
+	key, err := repo.UseKey(context.Background(), "hash")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, key.UserID)
+	assert.Equal(t, []string{"write"}, key.Scopes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryUseKeyNotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("UPDATE api_keys SET last_used_at").
+		WithArgs("revoked").
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.UseKey(context.Background(), "revoked")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryRevokeKeyNotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("UPDATE api_keys SET revoked_at").
+		WithArgs(uint(3), 2).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err := repo.RevokeKey(context.Background(), 2, 3)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,107 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+type DB interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, optionsAndArgs ...any) (pgx.Rows, error)
+}
+
+type Repository struct {
+	db DB
+}
+
+func New(db DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// GetRecurringEventsBefore returns the recurring series that started before
+// the cutoff; the caller decides which of them have already ended.
+func (r *Repository) GetRecurringEventsBefore(ctx context.Context, before time.Time) ([]*models.Event, error) {
+	query := `
+		SELECT id, user_id, title, date, end_date, all_day, time_zone, reminders, rrule, exdates
+		FROM events
+		WHERE rrule <> '' AND date < $1
+    `
+
+	rows, err := r.db.Query(ctx, query, before)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetRecurringEventsBefore - %w", err)
+	}
+	defer rows.Close()
+
+	events := []*models.Event{}
+	for rows.Next() {
+		var e models.Event
+		err := rows.Scan(&e.ID, &e.UserID, &e.Title, &e.Date, &e.EndDate, &e.AllDay, &e.TimeZone, &e.Reminders,
+			&e.RRule, &e.ExDates)
+		if err != nil {
+			return nil, fmt.Errorf("repository/GetRecurringEventsBefore - %w", err)
+		}
+
+		events = append(events, &e)
+	}
+
+	return events, nil
+}
+
+// ArchiveEvents moves single events that ended before the cutoff and the given
+// finished series into events_archive in one statement, together with their
+// attendees and tags, and drops their reminder deliveries. It returns the number of
+// archived events.
+func (r *Repository) ArchiveEvents(ctx context.Context, before time.Time, seriesIDs []uint) (int64, error) {
+	if seriesIDs == nil {
+		seriesIDs = []uint{}
+	}
+
+	query := `
+		WITH moved AS (
+		    DELETE FROM events
+		    WHERE (rrule = '' AND end_date < $1) OR id = ANY($2)
+		    RETURNING id, user_id, calendar_id, title, description, location, url, metadata,
+		              date, end_date, all_day, time_zone, reminders, rrule, exdates, uid
+		), deliveries AS (
+		    DELETE FROM reminder_deliveries
+		    WHERE event_id IN (SELECT id FROM moved)
+		), attendees AS (
+		    DELETE FROM event_attendees
+		    WHERE event_id IN (SELECT id FROM moved)
+		    RETURNING event_id, user_id, status, invited_at
+		), archived_attendees AS (
+		    INSERT INTO event_attendees_archive (event_id, user_id, status, invited_at)
+		    SELECT event_id, user_id, status, invited_at FROM attendees
+		), tags AS (
+		    DELETE FROM event_tags
+		    WHERE event_id IN (SELECT id FROM moved)
+		    RETURNING event_id, tag_id
+		), archived_tags AS (
+		    INSERT INTO event_tags_archive (event_id, tag_id)
+		    SELECT event_id, tag_id FROM tags
+		)
+		INSERT INTO events_archive (
+		    id, user_id, calendar_id, title, description, location, url, metadata,
+		    date, end_date, all_day, time_zone, reminders, rrule, exdates, uid
+		)
+		SELECT id, user_id, calendar_id, title, description, location, url, metadata,
+		       date, end_date, all_day, time_zone, reminders, rrule, exdates, uid
+		FROM moved;
+    `
+
+	cmdTag, err := r.db.Exec(ctx, query, before, seriesIDs)
+	if err != nil {
+		return 0, fmt.Errorf("repository/ArchiveEvents - %w", err)
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
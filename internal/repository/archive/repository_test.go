@@ -0,0 +1,34 @@
+package archive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepositoryArchiveEvents(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	before := time.Now()
+
+	mock.ExpectExec("INSERT INTO events_archive").
+		WithArgs(before, []uint{}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 3))
+
+	n, err := repo.ArchiveEvents(context.Background(), before, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
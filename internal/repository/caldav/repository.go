@@ -0,0 +1,169 @@
+package caldav
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/ical"
+)
+
+var (
+	ErrEventNotFound = errors.New("event not found")
+)
+
+type DB interface {
+	Query(ctx context.Context, sql string, optionsAndArgs ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, optionsAndArgs ...any) pgx.Row
+}
+
+type Repository struct {
+	db DB
+}
+
+func New(db DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// GetEvents returns every stored event of the user, unexpanded, with the UID
+// and version CalDAV resources are built from.
+func (r *Repository) GetEvents(ctx context.Context, userID int) ([]*models.Event, error) {
+	query := `
+		SELECT id, user_id, title, description, location, url, metadata,
+		       date, end_date, all_day, time_zone, reminders, rrule, exdates, uid, version
+		FROM events
+		WHERE user_id = $1
+		ORDER BY date
+    `
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetEvents - %w", err)
+	}
+
+	events, err := scanEvents(rows)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetEvents - %w", err)
+	}
+
+	return events, nil
+}
+
+// GetOverrides returns the user's overridden occurrences of the series with
+// the UID: they are stored as separate events with UIDs "<UID>_<recurrence>".
+func (r *Repository) GetOverrides(ctx context.Context, userID int, UID string) ([]*models.Event, error) {
+	query := `
+		SELECT id, user_id, title, description, location, url, metadata,
+		       date, end_date, all_day, time_zone, reminders, rrule, exdates, uid, version
+		FROM events
+		WHERE user_id = $1 AND starts_with(uid, $2)
+		ORDER BY date
+    `
+
+	rows, err := r.db.Query(ctx, query, userID, UID+"_")
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetOverrides - %w", err)
+	}
+
+	events, err := scanEvents(rows)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetOverrides - %w", err)
+	}
+
+	return events, nil
+}
+
+func scanEvents(rows pgx.Rows) ([]*models.Event, error) {
+	defer rows.Close()
+
+	events := []*models.Event{}
+	for rows.Next() {
+		var e models.Event
+		err := rows.Scan(&e.ID, &e.UserID, &e.Title, &e.Description, &e.Location, &e.URL, &e.Metadata, &e.Date,
+			&e.EndDate, &e.AllDay, &e.TimeZone, &e.Reminders, &e.RRule, &e.ExDates, &e.UID, &e.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, &e)
+	}
+
+	return events, rows.Err()
+}
+
+// GetEventByUID finds the user's event by its iCalendar UID, including the
+// UIDs generated from IDs for events that were not imported.
+func (r *Repository) GetEventByUID(ctx context.Context, userID int, UID string) (*models.Event, error) {
+	query := `
+		SELECT id, user_id, title, description, location, url, metadata,
+		       date, end_date, all_day, time_zone, reminders, rrule, exdates, uid, version
+		FROM events
+		WHERE user_id = $1 AND (uid = $2 OR (uid = '' AND id = $3));
+    `
+
+	ID, _ := ical.ParseUID(UID)
+
+	var e models.Event
+	err := r.db.QueryRow(ctx, query, userID, UID, int64(ID)).Scan(&e.ID, &e.UserID, &e.Title,
+		&e.Description, &e.Location, &e.URL, &e.Metadata, &e.Date, &e.EndDate, &e.AllDay, &e.TimeZone, &e.Reminders,
+		&e.RRule, &e.ExDates, &e.UID, &e.Version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrEventNotFound
+		}
+
+		return nil, fmt.Errorf("repository/GetEventByUID - %w", err)
+	}
+
+	return &e, nil
+}
+
+// GetSyncToken returns the latest change version of the user's events.
+func (r *Repository) GetSyncToken(ctx context.Context, userID int) (int64, error) {
+	query := `
+		SELECT COALESCE(MAX(version), 0)
+		FROM event_changes
+		WHERE user_id = $1;
+    `
+
+	var token int64
+	if err := r.db.QueryRow(ctx, query, userID).Scan(&token); err != nil {
+		return 0, fmt.Errorf("repository/GetSyncToken - %w", err)
+	}
+
+	return token, nil
+}
+
+// GetChanges returns the latest change of every event of the user changed in
+// (since, until].
+func (r *Repository) GetChanges(ctx context.Context, userID int, since, until int64) ([]*models.EventChange, error) {
+	query := `
+		SELECT DISTINCT ON (event_id) event_id, uid, version, deleted
+		FROM event_changes
+		WHERE user_id = $1 AND version > $2 AND version <= $3
+		ORDER BY event_id, version DESC
+    `
+
+	rows, err := r.db.Query(ctx, query, userID, since, until)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetChanges - %w", err)
+	}
+	defer rows.Close()
+
+	changes := []*models.EventChange{}
+	for rows.Next() {
+		var c models.EventChange
+		if err := rows.Scan(&c.EventID, &c.UID, &c.Version, &c.Deleted); err != nil {
+			return nil, fmt.Errorf("repository/GetChanges - %w", err)
+		}
+
+		changes = append(changes, &c)
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,72 @@
+package caldav
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepositoryGetEventByGeneratedUID(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM events").
+		WithArgs(1, "event-5@calendar-service", int64(5)).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetEventByUID(context.Background(), 1, "event-5@calendar-service")
+	assert.ErrorIs(t, err, ErrEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetOverrides(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	columns := []string{"id", "user_id", "title", "description", "location", "url", "metadata", "date", "end_date",
+		"all_day", "time_zone", "reminders", "rrule", "exdates", "uid", "version"}
+	mock.ExpectQuery("SELECT (.+) FROM events WHERE user_id = \\$1 AND starts_with\\(uid, \\$2\\)").
+		WithArgs(1, "abc@example.com_").
+		WillReturnRows(pgxmock.NewRows(columns).
+			AddRow(uint(8), 1, "Late standup", "", "", "", map[string]any(nil), time.Time{}, time.Time{}, false, "UTC",
+				[]int(nil), "", []time.Time(nil), "abc@example.com_20260112T090000Z", int64(44)))
+
+	events, err := repo.GetOverrides(context.Background(), 1, "abc@example.com")
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "abc@example.com_20260112T090000Z", events[0].UID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetChanges(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM event_changes").
+		WithArgs(1, int64(10), int64(20)).
+		WillReturnRows(pgxmock.NewRows([]string{"event_id", "uid", "version", "deleted"}).
+			AddRow(uint(3), "", int64(15), false).
+			AddRow(uint(4), "a@example.com", int64(18), true))
+
+	changes, err := repo.GetChanges(context.Background(), 1, 10, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.EventChange{
+		{EventID: 3, Version: 15},
+		{EventID: 4, UID: "a@example.com", Version: 18, Deleted: true},
+	}, changes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,197 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+var (
+	ErrCalendarNotFound = errors.New("calendar not found")
+	ErrMemberNotFound   = errors.New("calendar member not found")
+	ErrLastOwner        = errors.New("calendar must keep at least one owner")
+)
+
+// calendarOwners locks the owners of the calendar in $1, so that concurrent
+// changes of the membership wait for each other and then see the owners left.
+const calendarOwners = `
+		SELECT user_id FROM calendar_members
+		WHERE calendar_id = $1 AND role = 'owner'
+		FOR UPDATE`
+
+type DB interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, optionsAndArgs ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, optionsAndArgs ...any) pgx.Row
+}
+
+type Repository struct {
+	db DB
+}
+
+func New(db DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// CreateCalendar creates a calendar owned by the user.
+func (r *Repository) CreateCalendar(ctx context.Context, calendar *models.CalendarCreate) (uint, error) {
+	query := `
+		WITH created AS (
+		    INSERT INTO calendars (owner_id, name)
+		    VALUES ($1, $2)
+		    RETURNING id
+		), member AS (
+		    INSERT INTO calendar_members (calendar_id, user_id, role)
+		    SELECT id, $1, 'owner' FROM created
+		)
+		SELECT id FROM created;
+    `
+
+	var ID uint
+	if err := r.db.QueryRow(ctx, query, calendar.UserID, calendar.Name).Scan(&ID); err != nil {
+		return 0, fmt.Errorf("repository/CreateCalendar - %w", err)
+	}
+
+	return ID, nil
+}
+
+// GetCalendars returns the calendars the user is a member of, with the user's
+// role in each.
+func (r *Repository) GetCalendars(ctx context.Context, userID int) ([]*models.Calendar, error) {
+	query := `
+		SELECT c.id, c.owner_id, c.name, c.is_default, m.role
+		FROM calendars c
+		JOIN calendar_members m ON m.calendar_id = c.id
+		WHERE m.user_id = $1
+		ORDER BY c.id
+    `
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetCalendars - %w", err)
+	}
+	defer rows.Close()
+
+	calendars := []*models.Calendar{}
+	for rows.Next() {
+		var c models.Calendar
+		if err := rows.Scan(&c.ID, &c.OwnerID, &c.Name, &c.IsDefault, &c.Role); err != nil {
+			return nil, fmt.Errorf("repository/GetCalendars - %w", err)
+		}
+
+		calendars = append(calendars, &c)
+	}
+
+	return calendars, nil
+}
+
+// GetRole returns the user's role in the calendar. Calendars the user is not
+// a member of are reported as not found.
+func (r *Repository) GetRole(ctx context.Context, calendarID uint, userID int) (string, error) {
+	query := `
+		SELECT role
+		FROM calendar_members
+		WHERE calendar_id = $1 AND user_id = $2;
+    `
+
+	var role string
+	err := r.db.QueryRow(ctx, query, calendarID, userID).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrCalendarNotFound
+		}
+
+		return "", fmt.Errorf("repository/GetRole - %w", err)
+	}
+
+	return role, nil
+}
+
+func (r *Repository) GetMembers(ctx context.Context, calendarID uint) ([]*models.CalendarMember, error) {
+	query := `
+		SELECT calendar_id, user_id, role
+		FROM calendar_members
+		WHERE calendar_id = $1
+		ORDER BY user_id
+    `
+
+	rows, err := r.db.Query(ctx, query, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetMembers - %w", err)
+	}
+	defer rows.Close()
+
+	members := []*models.CalendarMember{}
+	for rows.Next() {
+		var m models.CalendarMember
+		if err := rows.Scan(&m.CalendarID, &m.UserID, &m.Role); err != nil {
+			return nil, fmt.Errorf("repository/GetMembers - %w", err)
+		}
+
+		members = append(members, &m)
+	}
+
+	return members, nil
+}
+
+// SetMember grants the user a role in the calendar or changes the role they
+// have. Demoting the calendar's only owner fails with ErrLastOwner.
+func (r *Repository) SetMember(ctx context.Context, member *models.CalendarMember) error {
+	query := `
+		WITH owners AS (` + calendarOwners + `
+		)
+		INSERT INTO calendar_members (calendar_id, user_id, role)
+		SELECT $1, $2, $3
+		WHERE $3 = 'owner'
+		   OR $2 NOT IN (SELECT user_id FROM owners)
+		   OR (SELECT count(*) FROM owners) > 1
+		ON CONFLICT (calendar_id, user_id) DO UPDATE SET role = EXCLUDED.role;
+    `
+
+	cmdTag, err := r.db.Exec(ctx, query, member.CalendarID, member.UserID, member.Role)
+	if err != nil {
+		return fmt.Errorf("repository/SetMember - %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrLastOwner
+	}
+
+	return nil
+}
+
+// DeleteMember removes the user from the calendar. Removing the calendar's
+// only owner fails with ErrLastOwner.
+func (r *Repository) DeleteMember(ctx context.Context, calendarID uint, userID int) error {
+	query := `
+		WITH owners AS (` + calendarOwners + `
+		), deleted AS (
+		    DELETE FROM calendar_members
+		    WHERE calendar_id = $1 AND user_id = $2
+		      AND ($2 NOT IN (SELECT user_id FROM owners) OR (SELECT count(*) FROM owners) > 1)
+		    RETURNING user_id
+		)
+		SELECT EXISTS (SELECT 1 FROM deleted), $2 IN (SELECT user_id FROM owners);
+    `
+
+	var deleted, owner bool
+	if err := r.db.QueryRow(ctx, query, calendarID, userID).Scan(&deleted, &owner); err != nil {
+		return fmt.Errorf("repository/DeleteMember - %w", err)
+	}
+
+	switch {
+	case deleted:
+		return nil
+	case owner:
+		return ErrLastOwner
+	default:
+		return ErrMemberNotFound
+	}
+}
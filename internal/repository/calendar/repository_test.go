@@ -0,0 +1,87 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepositoryCreateCalendar(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("INSERT INTO calendars").
+		WithArgs(1, "Team").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(uint(7)))
+
+	ID, err := repo.CreateCalendar(context.Background(), &models.CalendarCreate{UserID: 1, Name: "Team"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(7), ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetRoleNotMember(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("SELECT role FROM calendar_members").
+		WithArgs(uint(7), 2).
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetRole(context.Background(), 7, 2)
+	assert.ErrorIs(t, err, ErrCalendarNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryDeleteMemberNotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("DELETE FROM calendar_members").
+		WithArgs(uint(7), 2).
+		WillReturnRows(pgxmock.NewRows([]string{"deleted", "owner"}).AddRow(false, false))
+
+	err := repo.DeleteMember(context.Background(), 7, 2)
+	assert.ErrorIs(t, err, ErrMemberNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryDeleteLastOwner(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("FOR UPDATE").
+		WithArgs(uint(7), 1).
+		WillReturnRows(pgxmock.NewRows([]string{"deleted", "owner"}).AddRow(false, true))
+
+	err := repo.DeleteMember(context.Background(), 7, 1)
+	assert.ErrorIs(t, err, ErrLastOwner)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositorySetMemberLastOwner(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("INSERT INTO calendar_members").
+		WithArgs(uint(7), 1, models.CalendarRoleViewer).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+	member := &models.CalendarMember{CalendarID: 7, UserID: 1, Role: models.CalendarRoleViewer}
+	err := repo.SetMember(context.Background(), member)
+	assert.ErrorIs(t, err, ErrLastOwner)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,108 @@
+package event
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+// AddAttendees invites the users to the event. Users who are already invited
+// keep their answer.
+func (r *Repository) AddAttendees(ctx context.Context, eventID uint, userIDs []int) error {
+	query := `
+		INSERT INTO event_attendees (event_id, user_id)
+		SELECT $1, u FROM unnest($2::int[]) u
+		ON CONFLICT (event_id, user_id) DO NOTHING;
+    `
+
+	_, err := r.conn(ctx).Exec(ctx, query, eventID, userIDs)
+	if err != nil {
+		return fmt.Errorf("repository/AddAttendees - %w", err)
+	}
+
+	return nil
+}
+
+// CopyAttendees invites the attendees of one event, with their answers, to
+// another. It is used when an occurrence is split off a series.
+func (r *Repository) CopyAttendees(ctx context.Context, fromID, toID uint) error {
+	query := `
+		INSERT INTO event_attendees (event_id, user_id, status)
+		SELECT $2, user_id, status FROM event_attendees WHERE event_id = $1
+		ON CONFLICT (event_id, user_id) DO NOTHING;
+    `
+
+	_, err := r.conn(ctx).Exec(ctx, query, fromID, toID)
+	if err != nil {
+		return fmt.Errorf("repository/CopyAttendees - %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetAttendees(ctx context.Context, eventID uint) ([]*models.Attendee, error) {
+	query := `
+		SELECT user_id, status
+		FROM event_attendees
+		WHERE event_id = $1
+		ORDER BY invited_at, user_id
+    `
+
+	rows, err := r.conn(ctx).Query(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetAttendees - %w", err)
+	}
+	defer rows.Close()
+
+	attendees := []*models.Attendee{}
+	for rows.Next() {
+		var a models.Attendee
+		if err := rows.Scan(&a.UserID, &a.Status); err != nil {
+			return nil, fmt.Errorf("repository/GetAttendees - %w", err)
+		}
+
+		attendees = append(attendees, &a)
+	}
+
+	return attendees, nil
+}
+
+// SetAttendeeStatus records the attendee's answer. Users who are not invited
+// get ErrAttendeeNotFound.
+func (r *Repository) SetAttendeeStatus(ctx context.Context, rsvp *models.EventRSVP) error {
+	query := `
+		UPDATE event_attendees
+		SET status = $3
+		WHERE event_id = $1 AND user_id = $2;
+    `
+
+	cmdTag, err := r.conn(ctx).Exec(ctx, query, rsvp.EventID, rsvp.UserID, rsvp.Status)
+	if err != nil {
+		return fmt.Errorf("repository/SetAttendeeStatus - %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrAttendeeNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteAttendee(ctx context.Context, eventID uint, userID int) error {
+	query := `
+		DELETE FROM event_attendees
+		WHERE event_id = $1 AND user_id = $2;
+    `
+
+	cmdTag, err := r.conn(ctx).Exec(ctx, query, eventID, userID)
+	if err != nil {
+		return fmt.Errorf("repository/DeleteAttendee - %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrAttendeeNotFound
+	}
+
+	return nil
+}
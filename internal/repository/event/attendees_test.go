@@ -0,0 +1,57 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func TestRepositoryAddAttendees(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("INSERT INTO event_attendees").
+		WithArgs(uint(1), []int{2, 3}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+
+	err := repo.AddAttendees(context.Background(), 1, []int{2, 3})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetAttendees(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("SELECT user_id, status FROM event_attendees").
+		WithArgs(uint(1)).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "status"}).
+			AddRow(2, models.AttendeeAccepted).
+			AddRow(3, models.AttendeeNeedsAction))
+
+	attendees, err := repo.GetAttendees(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Attendee{
+		{UserID: 2, Status: models.AttendeeAccepted},
+		{UserID: 3, Status: models.AttendeeNeedsAction},
+	}, attendees)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositorySetAttendeeStatusNotInvited(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	rsvp := &models.EventRSVP{EventID: 1, UserID: 5, Status: models.AttendeeDeclined}
+	mock.ExpectExec("UPDATE event_attendees").
+		WithArgs(rsvp.EventID, rsvp.UserID, rsvp.Status).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err := repo.SetAttendeeStatus(context.Background(), rsvp)
+	assert.ErrorIs(t, err, ErrAttendeeNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
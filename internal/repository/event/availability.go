@@ -0,0 +1,78 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+// GetAvailability returns the users' time zones and working hours along with
+// their out-of-office periods that overlap [from, to). Users without settings
+// get the defaults.
+func (r *Repository) GetAvailability(ctx context.Context, userIDs []int, from, to time.Time) ([]*models.Availability, error) {
+	query := `
+		SELECT u.id, COALESCE(s.time_zone, 'UTC'), COALESCE(s.working_hours, '[]'::jsonb),
+		       ARRAY(SELECT start_at FROM out_of_office o
+		             WHERE o.user_id = u.id AND o.start_at < $3 AND o.end_at > $2 ORDER BY start_at, id),
+		       ARRAY(SELECT end_at FROM out_of_office o
+		             WHERE o.user_id = u.id AND o.start_at < $3 AND o.end_at > $2 ORDER BY start_at, id)
+		FROM unnest($1::int[]) u(id)
+		LEFT JOIN user_settings s ON s.user_id = u.id
+    `
+
+	rows, err := r.conn(ctx).Query(ctx, query, userIDs, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetAvailability - %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]*models.Availability, 0, len(userIDs))
+	for rows.Next() {
+		var (
+			a            models.Availability
+			starts, ends []time.Time
+		)
+		if err := rows.Scan(&a.UserID, &a.TimeZone, &a.WorkingHours, &starts, &ends); err != nil {
+			return nil, fmt.Errorf("repository/GetAvailability - %w", err)
+		}
+
+		for i := range starts {
+			a.OutOfOffice = append(a.OutOfOffice, models.OutOfOffice{UserID: a.UserID, Start: starts[i], End: ends[i]})
+		}
+
+		result = append(result, &a)
+	}
+
+	return result, nil
+}
+
+// CalendarPeers returns those of userIDs who are members of a calendar the
+// user is a member of.
+func (r *Repository) CalendarPeers(ctx context.Context, userID int, userIDs []int) ([]int, error) {
+	query := `
+		SELECT DISTINCT o.user_id
+		FROM calendar_members m
+		JOIN calendar_members o ON o.calendar_id = m.calendar_id
+		WHERE m.user_id = $1 AND o.user_id = ANY($2)
+    `
+
+	rows, err := r.conn(ctx).Query(ctx, query, userID, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("repository/CalendarPeers - %w", err)
+	}
+	defer rows.Close()
+
+	peers := []int{}
+	for rows.Next() {
+		var peer int
+		if err := rows.Scan(&peer); err != nil {
+			return nil, fmt.Errorf("repository/CalendarPeers - %w", err)
+		}
+
+		peers = append(peers, peer)
+	}
+
+	return peers, nil
+}
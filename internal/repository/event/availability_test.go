@@ -0,0 +1,54 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func TestRepositoryGetAvailability(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	hours := []models.WorkingHours{{Start: "09:00", End: "18:00"}}
+
+	mock.ExpectQuery("FROM unnest").
+		WithArgs([]int{1, 2}, from, to).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "time_zone", "working_hours", "ooo_start", "ooo_end"}).
+			AddRow(1, "Europe/Moscow", hours, []time.Time{from.Add(time.Hour)}, []time.Time{from.Add(2 * time.Hour)}).
+			AddRow(2, "UTC", []models.WorkingHours{}, []time.Time{}, []time.Time{}))
+
+	availability, err := repo.GetAvailability(context.Background(), []int{1, 2}, from, to)
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Availability{
+		{
+			UserID:       1,
+			TimeZone:     "Europe/Moscow",
+			WorkingHours: hours,
+			OutOfOffice:  []models.OutOfOffice{{UserID: 1, Start: from.Add(time.Hour), End: from.Add(2 * time.Hour)}},
+		},
+		{UserID: 2, TimeZone: "UTC", WorkingHours: []models.WorkingHours{}},
+	}, availability)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryCalendarPeers(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("SELECT DISTINCT o.user_id FROM calendar_members m").
+		WithArgs(1, []int{2, 3}).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id"}).AddRow(3))
+
+	peers, err := repo.CalendarPeers(context.Background(), 1, []int{2, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3}, peers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
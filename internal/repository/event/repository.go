@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/avraam311/calendar-service/internal/models"
 	"github.com/jackc/pgx/v5"
@@ -11,13 +14,23 @@ import (
 )
 
 var (
-	ErrEventNotFound = errors.New("event not found")
+	ErrEventNotFound    = errors.New("event not found")
+	ErrForbidden        = errors.New("access to the event is forbidden")
+	ErrCalendarNotFound = errors.New("calendar not found")
+	ErrAttendeeNotFound = errors.New("attendee not found")
+	ErrTagNotFound      = errors.New("tag not found")
+	ErrTagExists        = errors.New("tag with this name already exists")
 )
 
+// writableCalendars selects the calendars the user in $1 may change events in.
+const writableCalendars = `
+		SELECT calendar_id FROM calendar_members WHERE user_id = $1 AND role IN ('owner', 'editor')`
+
 type DB interface {
 	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
 	Query(ctx context.Context, sql string, optionsAndArgs ...any) (pgx.Rows, error)
 	QueryRow(ctx context.Context, sql string, optionsAndArgs ...any) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
 }
 
 type Repository struct {
@@ -30,15 +43,54 @@ func New(db DB) *Repository {
 	}
 }
 
+type txKey struct{}
+
+// InTx runs fn in one transaction: every query the repository makes with the
+// context passed to fn goes through it. The transaction is committed if fn
+// returns nil and rolled back otherwise; nested calls join the outer one.
+func (r *Repository) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("repository/InTx - %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err = fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("repository/InTx - %w", err)
+	}
+
+	return nil
+}
+
+// conn returns the transaction started by InTx, if ctx carries one.
+func (r *Repository) conn(ctx context.Context) DB {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+
+	return r.db
+}
+
 func (r *Repository) CreateEvent(ctx context.Context, event *models.EventCreate) (uint, error) {
 	query := `
 		INSERT INTO events (
-		    user_id, event, date
-		) VALUES ($1, $2, $3)
+		    user_id, title, description, location, url, metadata,
+		    date, end_date, all_day, time_zone, reminders, rrule, exdates, uid, calendar_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 		RETURNING id;
     `
 	var ID uint
-	err := r.db.QueryRow(ctx, query, event.UserID, event.Event, event.Date).Scan(&ID)
+	err := r.conn(ctx).QueryRow(ctx, query, event.UserID, event.Title, event.Description, event.Location, event.URL,
+		metadata(event.Metadata), event.Date, event.EndDate, event.AllDay, event.TimeZone, reminders(event.Reminders),
+		event.RRule, exDates(event.ExDates), event.UID, event.CalendarID).Scan(&ID)
 	if err != nil {
 		return 0, fmt.Errorf("repository/CreateEvent - %w", err)
 	}
@@ -46,52 +98,298 @@ func (r *Repository) CreateEvent(ctx context.Context, event *models.EventCreate)
 	return ID, nil
 }
 
+// UpdateEvent changes the event on behalf of event.UserID, who must be able to
+// edit its calendar. The event keeps its creator and calendar.
 func (r *Repository) UpdateEvent(ctx context.Context, event *models.Event) (uint, error) {
 	query := `
 		UPDATE events
 		SET
-			user_id = $1,
-			event = $2,
-		    date = $3
-		WHERE id = $4;
+			title = $2,
+		    description = $3,
+		    location = $4,
+		    url = $5,
+		    metadata = $6,
+		    date = $7,
+		    end_date = $8,
+		    all_day = $9,
+		    time_zone = $10,
+		    reminders = $11,
+		    rrule = $12,
+		    exdates = $13
+		WHERE id = $14 AND calendar_id IN (` + writableCalendars + `);
 	`
 
-	_, err := r.db.Exec(ctx, query, event.UserID, event.Event, event.Date, event.ID)
-
+	cmdTag, err := r.conn(ctx).Exec(ctx, query, event.UserID, event.Title, event.Description, event.Location, event.URL,
+		metadata(event.Metadata), event.Date, event.EndDate, event.AllDay, event.TimeZone, reminders(event.Reminders),
+		event.RRule, exDates(event.ExDates), event.ID)
 	if err != nil {
 		return 0, fmt.Errorf("repository/UpdateEvent - %w", err)
 	}
 
+	if cmdTag.RowsAffected() == 0 {
+		return 0, r.missingEventError(ctx, event.ID)
+	}
+
 	return event.ID, nil
 }
 
-func (r *Repository) DeleteEvent(ctx context.Context, ID uint) (uint, error) {
+func (r *Repository) DeleteEvent(ctx context.Context, userID int, ID uint) (uint, error) {
 	query := `
    		DELETE FROM events
-   		WHERE id = $1;
+   		WHERE id = $2 AND calendar_id IN (` + writableCalendars + `);
     `
 
-	cmdTag, err := r.db.Exec(ctx, query, ID)
+	cmdTag, err := r.conn(ctx).Exec(ctx, query, userID, ID)
+	if err != nil {
+		return 0, fmt.Errorf("repository/DeleteEvent - %w", err)
+	}
+
 	if cmdTag.RowsAffected() == 0 {
-		return 0, ErrEventNotFound
+		return 0, r.missingEventError(ctx, ID)
 	}
 
+	return ID, nil
+}
+
+// GetEvent returns an event the user may edit.
+func (r *Repository) GetEvent(ctx context.Context, userID int, ID uint) (*models.Event, error) {
+	query := `
+		SELECT id, user_id, calendar_id, title, description, location, url, metadata,
+		       date, end_date, all_day, time_zone, reminders, rrule, exdates
+		FROM events
+		WHERE id = $2 AND calendar_id IN (` + writableCalendars + `);
+    `
+
+	var e models.Event
+	err := r.conn(ctx).QueryRow(ctx, query, userID, ID).Scan(&e.ID, &e.UserID, &e.CalendarID, &e.Title,
+		&e.Description, &e.Location, &e.URL, &e.Metadata, &e.Date, &e.EndDate, &e.AllDay, &e.TimeZone, &e.Reminders,
+		&e.RRule, &e.ExDates)
 	if err != nil {
-		return 0, fmt.Errorf("repository/DeleteEvent - %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.missingEventError(ctx, ID)
+		}
+
+		return nil, fmt.Errorf("repository/GetEvent - %w", err)
+	}
+
+	return &e, nil
+}
+
+// missingEventError tells why a query scoped to the user matched no event:
+// ErrForbidden if the event exists in a calendar the user cannot edit,
+// ErrEventNotFound otherwise.
+func (r *Repository) missingEventError(ctx context.Context, ID uint) error {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM events WHERE id = $1);
+    `
+
+	var exists bool
+	if err := r.conn(ctx).QueryRow(ctx, query, ID).Scan(&exists); err != nil {
+		return fmt.Errorf("repository/missingEventError - %w", err)
+	}
+
+	if exists {
+		return ErrForbidden
+	}
+
+	return ErrEventNotFound
+}
+
+// WritableCalendar checks that the user may add events to the calendar and
+// returns its ID. Zero stands for the user's default calendar, which is
+// created on first use.
+func (r *Repository) WritableCalendar(ctx context.Context, userID int, calendarID uint) (uint, error) {
+	if calendarID == 0 {
+		return r.defaultCalendar(ctx, userID)
+	}
+
+	query := `
+		SELECT role
+		FROM calendar_members
+		WHERE calendar_id = $1 AND user_id = $2;
+    `
+
+	var role string
+	err := r.conn(ctx).QueryRow(ctx, query, calendarID, userID).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrCalendarNotFound
+		}
+
+		return 0, fmt.Errorf("repository/WritableCalendar - %w", err)
+	}
+
+	if role != models.CalendarRoleOwner && role != models.CalendarRoleEditor {
+		return 0, ErrForbidden
+	}
+
+	return calendarID, nil
+}
+
+func (r *Repository) defaultCalendar(ctx context.Context, userID int) (uint, error) {
+	query := `
+		WITH created AS (
+		    INSERT INTO calendars (owner_id, name, is_default)
+		    VALUES ($1, 'Personal', TRUE)
+		    ON CONFLICT (owner_id) WHERE is_default DO NOTHING
+		    RETURNING id
+		), member AS (
+		    INSERT INTO calendar_members (calendar_id, user_id, role)
+		    SELECT id, $1, 'owner' FROM created
+		)
+		SELECT id FROM created
+		UNION ALL
+		SELECT id FROM calendars WHERE owner_id = $1 AND is_default
+		LIMIT 1;
+    `
+
+	var ID uint
+	err := r.conn(ctx).QueryRow(ctx, query, userID).Scan(&ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// A concurrent first use created the calendar after this statement's
+		// snapshot was taken: ON CONFLICT waited for it to commit, so a new
+		// statement sees it.
+		query = `
+			SELECT id FROM calendars WHERE owner_id = $1 AND is_default;
+        `
+		err = r.conn(ctx).QueryRow(ctx, query, userID).Scan(&ID)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("repository/defaultCalendar - %w", err)
 	}
 
 	return ID, nil
 }
 
-func (r *Repository) GetEvents(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error) {
+// GetEventByUID returns the user's event imported with the given UID, or nil
+// when there is none. An event the user may no longer edit is forbidden.
+func (r *Repository) GetEventByUID(ctx context.Context, userID int, UID string) (*models.Event, error) {
 	query := `
-		SELECT id, user_id, event, date
+		SELECT id, user_id, calendar_id, title, description, location, url, metadata,
+		       date, end_date, all_day, time_zone, reminders, rrule, exdates
 		FROM events
-		WHERE user_id = $1 AND date >= $2 AND date <= $3
-		ORDER BY date
+		WHERE user_id = $1 AND uid = $2 AND calendar_id IN (` + writableCalendars + `);
     `
 
-	rows, err := r.db.Query(ctx, query, eventGet.UserID, eventGet.DateFrom, eventGet.DateTo)
+	var e models.Event
+	err := r.conn(ctx).QueryRow(ctx, query, userID, UID).Scan(&e.ID, &e.UserID, &e.CalendarID, &e.Title,
+		&e.Description, &e.Location, &e.URL, &e.Metadata, &e.Date, &e.EndDate, &e.AllDay, &e.TimeZone, &e.Reminders,
+		&e.RRule, &e.ExDates)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.missingUIDError(ctx, userID, UID)
+		}
+
+		return nil, fmt.Errorf("repository/GetEventByUID - %w", err)
+	}
+
+	return &e, nil
+}
+
+// missingUIDError tells an event with the UID in a calendar the user may not
+// edit, which the UID stays taken by, from no event at all.
+func (r *Repository) missingUIDError(ctx context.Context, userID int, UID string) error {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM events WHERE user_id = $1 AND uid = $2);
+    `
+
+	var exists bool
+	if err := r.conn(ctx).QueryRow(ctx, query, userID, UID).Scan(&exists); err != nil {
+		return fmt.Errorf("repository/missingUIDError - %w", err)
+	}
+
+	if exists {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// GetEvents returns single events that overlap the half-open window and every
+// recurring series that started before its end, from all calendars the user is
+// a member of and from the events the user is invited to; the service expands
+// the series. Members with free/busy access only see when events take place,
+// unless they are invited, and such events never match a text query. The
+// optional filters of eventGet narrow the result down.
+//
+// Single events are ordered by date and ID in the direction of eventGet.Sort
+// and follow eventGet.After; with a limit at most eventGet.Limit+1 of them are
+// read, so that the caller can tell whether there is another page. Series are
+// always returned in full, as their occurrences may fall anywhere in the
+// window.
+func (r *Repository) GetEvents(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error) {
+	args := []any{eventGet.UserID, eventGet.DateFrom, eventGet.DateTo}
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	// Only placeholders and fixed fragments go into the query text.
+	var filters strings.Builder
+	if len(eventGet.Tags) > 0 {
+		filters.WriteString(`
+		      AND EXISTS (` + userTagsIn(arg(eventGet.Tags)) + `)`)
+	}
+	if len(eventGet.ExcludeTags) > 0 {
+		filters.WriteString(`
+		      AND NOT EXISTS (` + userTagsIn(arg(eventGet.ExcludeTags)) + `)`)
+	}
+	if len(eventGet.OwnerIDs) > 0 {
+		filters.WriteString(`
+		      AND e.user_id = ANY(` + arg(eventGet.OwnerIDs) + `)`)
+	}
+	if len(eventGet.CalendarIDs) > 0 {
+		filters.WriteString(`
+		      AND e.calendar_id = ANY(` + arg(eventGet.CalendarIDs) + `)`)
+	}
+	if eventGet.TSQuery != "" {
+		filters.WriteString(`
+		      AND NOT redacted AND e.search @@ to_tsquery('simple', ` + arg(eventGet.TSQuery) + `)`)
+	}
+
+	order, following := "date, id", ">"
+	if eventGet.Sort == models.SortDateDesc {
+		order, following = "date DESC, id DESC", "<"
+	}
+
+	var page string
+	if eventGet.After != nil {
+		page += ` AND (date, id) ` + following + ` (` + arg(eventGet.After.Date) + `, ` + arg(eventGet.After.ID) + `)`
+	}
+	page += `
+		 ORDER BY ` + order
+	if eventGet.Limit > 0 {
+		page += `
+		 LIMIT ` + arg(eventGet.Limit+1)
+	}
+
+	query := `
+		WITH visible AS (
+		    SELECT e.id, e.user_id, e.calendar_id,
+		           CASE WHEN redacted THEN '' ELSE e.title END AS title,
+		           CASE WHEN redacted THEN '' ELSE e.description END AS description,
+		           CASE WHEN redacted THEN '' ELSE e.location END AS location,
+		           CASE WHEN redacted THEN '' ELSE e.url END AS url,
+		           CASE WHEN redacted THEN '{}' ELSE e.metadata END AS metadata,
+		           e.date, e.end_date, e.all_day, e.time_zone,
+		           CASE WHEN redacted THEN '{}' ELSE e.reminders END AS reminders,
+		           e.rrule, e.exdates, COALESCE(a.status, '') AS rsvp_status,
+		           ARRAY(SELECT t.name FROM event_tags et JOIN tags t ON t.id = et.tag_id
+		                 WHERE et.event_id = e.id AND t.user_id = $1 ORDER BY lower(t.name)) AS tags
+		    FROM events e
+		    LEFT JOIN calendar_members m ON m.calendar_id = e.calendar_id AND m.user_id = $1
+		    LEFT JOIN event_attendees a ON a.event_id = e.id AND a.user_id = $1
+		    CROSS JOIN LATERAL (SELECT m.role = 'freebusy' AND a.status IS NULL) f(redacted)
+		    WHERE (m.user_id IS NOT NULL OR a.user_id IS NOT NULL)
+		      AND e.date < $3 AND (e.rrule <> '' OR e.end_date > $2 OR e.date >= $2)` + filters.String() + `
+		)
+		(SELECT * FROM visible
+		 WHERE rrule = ''` + page + `)
+		UNION ALL
+		(SELECT * FROM visible WHERE rrule <> '')
+    `
+
+	rows, err := r.conn(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("repository/GetEvents - %w", err)
 	}
@@ -100,7 +398,10 @@ func (r *Repository) GetEvents(ctx context.Context, eventGet *models.EventGet) (
 	events := []*models.Event{}
 	for rows.Next() {
 		var e models.Event
-		if err := rows.Scan(&e.ID, &e.UserID, &e.Event, &e.Date); err != nil {
+		err := rows.Scan(&e.ID, &e.UserID, &e.CalendarID, &e.Title, &e.Description, &e.Location, &e.URL,
+			&e.Metadata, &e.Date, &e.EndDate, &e.AllDay, &e.TimeZone, &e.Reminders, &e.RRule, &e.ExDates, &e.RSVPStatus,
+			&e.Tags)
+		if err != nil {
 			return nil, fmt.Errorf("repository/GetEvents - %w", err)
 		}
 
@@ -109,3 +410,81 @@ func (r *Repository) GetEvents(ctx context.Context, eventGet *models.EventGet) (
 
 	return events, nil
 }
+
+// userTagsIn selects the user's tags on the event whose names are in the
+// text array parameter, ignoring case.
+func userTagsIn(param string) string {
+	return `SELECT 1 FROM event_tags et JOIN tags t ON t.id = et.tag_id
+		          WHERE et.event_id = e.id AND t.user_id = $1
+		            AND lower(t.name) IN (SELECT lower(n) FROM unnest(` + param + `::text[]) n)`
+}
+
+// GetArchivedEvents reads events_archive with the same window semantics as
+// GetEvents.
+func (r *Repository) GetArchivedEvents(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error) {
+	query := `
+		SELECT e.id, e.user_id, e.calendar_id,
+		       CASE WHEN redacted THEN '' ELSE e.title END,
+		       CASE WHEN redacted THEN '' ELSE e.description END,
+		       CASE WHEN redacted THEN '' ELSE e.location END,
+		       CASE WHEN redacted THEN '' ELSE e.url END,
+		       CASE WHEN redacted THEN '{}' ELSE e.metadata END,
+		       e.date, e.end_date, e.all_day, e.time_zone,
+		       CASE WHEN redacted THEN '{}' ELSE e.reminders END,
+		       e.rrule, e.exdates, COALESCE(a.status, ''),
+		       ARRAY(SELECT t.name FROM event_tags_archive et JOIN tags t ON t.id = et.tag_id
+		             WHERE et.event_id = e.id AND t.user_id = $1 ORDER BY lower(t.name))
+		FROM events_archive e
+		LEFT JOIN calendar_members m ON m.calendar_id = e.calendar_id AND m.user_id = $1
+		LEFT JOIN event_attendees_archive a ON a.event_id = e.id AND a.user_id = $1
+		CROSS JOIN LATERAL (SELECT m.role = 'freebusy' AND a.status IS NULL) f(redacted)
+		WHERE (m.user_id IS NOT NULL OR a.user_id IS NOT NULL)
+		  AND e.date < $3 AND (e.rrule <> '' OR e.end_date > $2 OR e.date >= $2)
+		ORDER BY e.date
+    `
+
+	rows, err := r.conn(ctx).Query(ctx, query, eventGet.UserID, eventGet.DateFrom, eventGet.DateTo)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetArchivedEvents - %w", err)
+	}
+	defer rows.Close()
+
+	events := []*models.Event{}
+	for rows.Next() {
+		var e models.Event
+		err := rows.Scan(&e.ID, &e.UserID, &e.CalendarID, &e.Title, &e.Description, &e.Location, &e.URL,
+			&e.Metadata, &e.Date, &e.EndDate, &e.AllDay, &e.TimeZone, &e.Reminders, &e.RRule, &e.ExDates, &e.RSVPStatus,
+			&e.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("repository/GetArchivedEvents - %w", err)
+		}
+
+		events = append(events, &e)
+	}
+
+	return events, nil
+}
+
+func exDates(dates []time.Time) []time.Time {
+	if dates == nil {
+		return []time.Time{}
+	}
+
+	return dates
+}
+
+func metadata(m map[string]any) map[string]any {
+	if m == nil {
+		return map[string]any{}
+	}
+
+	return m
+}
+
+func reminders(minutes []int) []int {
+	if minutes == nil {
+		return []int{}
+	}
+
+	return minutes
+}
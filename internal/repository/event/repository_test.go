@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/pashagolub/pgxmock/v4"
 	"github.com/stretchr/testify/assert"
 
@@ -25,13 +26,15 @@ func TestRepositoryCreateEvent(t *testing.T) {
 
 	id := uint(1)
 	event := &models.EventCreate{
-		UserID: 1,
-		Event:  "Test event",
-		Date:   time.Now(),
+		UserID:     1,
+		CalendarID: 5,
+		Title:      "Test event",
+		Date:       time.Now(),
 	}
 
 	mock.ExpectQuery("INSERT INTO events").
-		WithArgs(event.UserID, event.Event, event.Date).
+		WithArgs(event.UserID, event.Title, "", "", "", map[string]any{}, event.Date, event.EndDate, event.AllDay,
+			event.TimeZone, []int{}, event.RRule, []time.Time{}, event.UID, event.CalendarID).
 		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(id))
 
 	gotID, err := repo.CreateEvent(context.Background(), event)
@@ -47,12 +50,13 @@ func TestRepositoryUpdateEvent(t *testing.T) {
 	event := &models.Event{
 		ID:     uint(1),
 		UserID: 2,
-		Event:  "Updated",
+		Title:  "Updated",
 		Date:   time.Now(),
 	}
 
 	mock.ExpectExec("UPDATE events").
-		WithArgs(event.UserID, event.Event, event.Date, event.ID).
+		WithArgs(event.UserID, event.Title, "", "", "", map[string]any{}, event.Date, event.EndDate, event.AllDay,
+			event.TimeZone, []int{}, event.RRule, []time.Time{}, event.ID).
 		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
 
 	_, err := repo.UpdateEvent(context.Background(), event)
@@ -67,10 +71,370 @@ func TestRepositoryDeleteEventNotFound(t *testing.T) {
 	eventID := uint(1)
 
 	mock.ExpectExec("DELETE FROM events").
+		WithArgs(2, eventID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mock.ExpectQuery("SELECT EXISTS").
 		WithArgs(eventID).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+
+	_, err := repo.DeleteEvent(context.Background(), 2, eventID)
+	assert.ErrorIs(t, err, ErrEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryDeleteEventForbidden(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID := uint(1)
+
+	mock.ExpectExec("DELETE FROM events").
+		WithArgs(2, eventID).
 		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(eventID).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+
+	_, err := repo.DeleteEvent(context.Background(), 2, eventID)
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryUpdateEventForbidden(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	event := &models.Event{ID: 1, UserID: 2, Title: "Hijacked", Date: time.Now()}
 
-	_, err := repo.DeleteEvent(context.Background(), eventID)
+	mock.ExpectExec("UPDATE events").
+		WithArgs(event.UserID, event.Title, "", "", "", map[string]any{}, event.Date, event.EndDate, event.AllDay,
+			event.TimeZone, []int{}, event.RRule, []time.Time{}, event.ID).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(event.ID).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+
+	_, err := repo.UpdateEvent(context.Background(), event)
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetEventNotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	eventID := uint(1)
+
+	mock.ExpectQuery("SELECT (.+) FROM events").
+		WithArgs(2, eventID).
+		WillReturnError(pgx.ErrNoRows)
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(eventID).
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+
+	_, err := repo.GetEvent(context.Background(), 2, eventID)
 	assert.ErrorIs(t, err, ErrEventNotFound)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestRepositoryGetEventByUIDNotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM events WHERE user_id = \\$1 AND uid = \\$2 AND calendar_id IN").
+		WithArgs(1, "a@example.com").
+		WillReturnError(pgx.ErrNoRows)
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(1, "a@example.com").
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+
+	event, err := repo.GetEventByUID(context.Background(), 1, "a@example.com")
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetEventByUIDReadOnly(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM events").
+		WithArgs(1, "a@example.com").
+		WillReturnError(pgx.ErrNoRows)
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(1, "a@example.com").
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+
+	_, err := repo.GetEventByUID(context.Background(), 1, "a@example.com")
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetEventsRecurring(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	eventGet := &models.EventGet{UserID: 1, DateFrom: from, DateTo: from.AddDate(0, 0, 7)}
+	exDates := []time.Time{from.AddDate(0, 0, 1)}
+
+	mock.ExpectQuery("SELECT (.+) FROM events").
+		WithArgs(eventGet.UserID, eventGet.DateFrom, eventGet.DateTo).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "user_id", "calendar_id", "title", "description", "location", "url", "metadata", "date", "end_date",
+			"all_day", "time_zone", "reminders", "rrule", "exdates", "rsvp_status", "tags",
+		}).AddRow(uint(1), 1, uint(5), "Standup", "", "", "", map[string]any{}, from.AddDate(0, -1, 0),
+			from.AddDate(0, -1, 0).Add(time.Hour), false, "Europe/Moscow", []int{10}, "FREQ=DAILY", exDates, "",
+			[]string{"work"}))
+
+	events, err := repo.GetEvents(context.Background(), eventGet)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "FREQ=DAILY", events[0].RRule)
+	assert.Equal(t, exDates, events[0].ExDates)
+	assert.Equal(t, uint(5), events[0].CalendarID)
+	assert.Equal(t, []string{"work"}, events[0].Tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetEventsHalfOpenWindow(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	eventGet := &models.EventGet{UserID: 1, DateFrom: from, DateTo: from.AddDate(0, 0, 1)}
+
+	mock.ExpectQuery(`e.date < \$3 AND \(e.rrule <> '' OR e.end_date > \$2 OR e.date >= \$2\)`).
+		WithArgs(eventGet.UserID, eventGet.DateFrom, eventGet.DateTo).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "user_id", "calendar_id", "title", "description", "location", "url", "metadata", "date", "end_date",
+			"all_day", "time_zone", "reminders", "rrule", "exdates", "rsvp_status", "tags",
+		}))
+
+	events, err := repo.GetEvents(context.Background(), eventGet)
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetEventsFromMemberCalendars(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	eventGet := &models.EventGet{UserID: 1, DateFrom: from, DateTo: from.AddDate(0, 0, 1)}
+
+	mock.ExpectQuery(`LEFT JOIN calendar_members m ON m.calendar_id = e.calendar_id AND m.user_id = \$1`).
+		WithArgs(eventGet.UserID, eventGet.DateFrom, eventGet.DateTo).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "user_id", "calendar_id", "title", "description", "location", "url", "metadata", "date", "end_date",
+			"all_day", "time_zone", "reminders", "rrule", "exdates", "rsvp_status", "tags",
+		}).AddRow(uint(1), 2, uint(9), "", "", "", "", map[string]any{}, from, from.Add(time.Hour), false, "UTC",
+			[]int{}, "", []time.Time{}, "", []string{}))
+
+	events, err := repo.GetEvents(context.Background(), eventGet)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, uint(9), events[0].CalendarID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetEventsInvited(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	eventGet := &models.EventGet{UserID: 1, DateFrom: from, DateTo: from.AddDate(0, 0, 1)}
+
+	mock.ExpectQuery(`LEFT JOIN event_attendees a ON a.event_id = e.id AND a.user_id = \$1`).
+		WithArgs(eventGet.UserID, eventGet.DateFrom, eventGet.DateTo).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "user_id", "calendar_id", "title", "description", "location", "url", "metadata", "date", "end_date",
+			"all_day", "time_zone", "reminders", "rrule", "exdates", "rsvp_status", "tags",
+		}).AddRow(uint(1), 2, uint(9), "Review", "", "", "", map[string]any{}, from, from.Add(time.Hour), false, "UTC",
+			[]int{}, "", []time.Time{}, models.AttendeeNeedsAction, []string{}))
+
+	events, err := repo.GetEvents(context.Background(), eventGet)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, models.AttendeeNeedsAction, events[0].RSVPStatus)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryWritableCalendar(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("SELECT role FROM calendar_members").
+		WithArgs(uint(9), 1).
+		WillReturnRows(pgxmock.NewRows([]string{"role"}).AddRow(models.CalendarRoleEditor))
+	mock.ExpectQuery("SELECT role FROM calendar_members").
+		WithArgs(uint(9), 2).
+		WillReturnRows(pgxmock.NewRows([]string{"role"}).AddRow(models.CalendarRoleViewer))
+	mock.ExpectQuery("SELECT role FROM calendar_members").
+		WithArgs(uint(9), 3).
+		WillReturnError(pgx.ErrNoRows)
+
+	ID, err := repo.WritableCalendar(context.Background(), 1, 9)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(9), ID)
+
+	_, err = repo.WritableCalendar(context.Background(), 2, 9)
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	_, err = repo.WritableCalendar(context.Background(), 3, 9)
+	assert.ErrorIs(t, err, ErrCalendarNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryWritableCalendarDefault(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("INSERT INTO calendars").
+		WithArgs(1).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(uint(4)))
+
+	ID, err := repo.WritableCalendar(context.Background(), 1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(4), ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryWritableCalendarDefaultCreatedConcurrently(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("INSERT INTO calendars").
+		WithArgs(1).
+		WillReturnError(pgx.ErrNoRows)
+	mock.ExpectQuery("SELECT id FROM calendars").
+		WithArgs(1).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(uint(4)))
+
+	ID, err := repo.WritableCalendar(context.Background(), 1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(4), ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetEventsTagFilters(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	eventGet := &models.EventGet{UserID: 1, DateFrom: from, DateTo: from.AddDate(0, 0, 1),
+		Tags: []string{"Work"}, ExcludeTags: []string{"private"}}
+
+	mock.ExpectQuery(`AND NOT EXISTS \(SELECT 1 FROM event_tags (.+) unnest\(\$5::text\[\]\) n\)\)`).
+		WithArgs(eventGet.UserID, eventGet.DateFrom, eventGet.DateTo, eventGet.Tags, eventGet.ExcludeTags).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "user_id", "calendar_id", "title", "description", "location", "url", "metadata", "date", "end_date",
+			"all_day", "time_zone", "reminders", "rrule", "exdates", "rsvp_status", "tags",
+		}))
+
+	events, err := repo.GetEvents(context.Background(), eventGet)
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetEventsAfterCursor(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	eventGet := &models.EventGet{UserID: 1, DateFrom: from, DateTo: from.AddDate(0, 1, 0), Limit: 2,
+		After: &models.EventCursor{Date: from.Add(9 * time.Hour), ID: 4}}
+
+	mock.ExpectQuery(`WHERE rrule = '' AND \(date, id\) > \(\$4, \$5\) ORDER BY date, id LIMIT \$6\)`).
+		WithArgs(eventGet.UserID, eventGet.DateFrom, eventGet.DateTo, eventGet.After.Date, eventGet.After.ID, 3).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "user_id", "calendar_id", "title", "description", "location", "url", "metadata", "date", "end_date",
+			"all_day", "time_zone", "reminders", "rrule", "exdates", "rsvp_status", "tags",
+		}).AddRow(uint(5), 1, uint(1), "Lunch", "", "", "", map[string]any{}, from.Add(12*time.Hour),
+			from.Add(13*time.Hour), false, "UTC", []int{}, "", []time.Time{}, "", []string{}))
+
+	events, err := repo.GetEvents(context.Background(), eventGet)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetEventsFilters(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	eventGet := &models.EventGet{UserID: 1, DateFrom: from, DateTo: from.AddDate(0, 1, 0),
+		OwnerIDs: []int{2, 3}, CalendarIDs: []uint{7}, TSQuery: "budget:*", Sort: models.SortDateDesc}
+
+	mock.ExpectQuery(`AND e.user_id = ANY\(\$4\) AND e.calendar_id = ANY\(\$5\) `+
+		`AND NOT redacted AND e.search @@ to_tsquery\('simple', \$6\) \) `+
+		`\(SELECT \* FROM visible WHERE rrule = '' ORDER BY date DESC, id DESC\)`).
+		WithArgs(eventGet.UserID, eventGet.DateFrom, eventGet.DateTo, eventGet.OwnerIDs, eventGet.CalendarIDs,
+			eventGet.TSQuery).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "user_id", "calendar_id", "title", "description", "location", "url", "metadata", "date", "end_date",
+			"all_day", "time_zone", "reminders", "rrule", "exdates", "rsvp_status", "tags",
+		}))
+
+	events, err := repo.GetEvents(context.Background(), eventGet)
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetEventsBeforeCursorDesc(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	eventGet := &models.EventGet{UserID: 1, DateFrom: from, DateTo: from.AddDate(0, 1, 0), Limit: 10,
+		Sort: models.SortDateDesc, After: &models.EventCursor{Date: from.Add(9 * time.Hour), ID: 4}}
+
+	mock.ExpectQuery(`\(date, id\) < \(\$4, \$5\) ORDER BY date DESC, id DESC LIMIT \$6\)`).
+		WithArgs(eventGet.UserID, eventGet.DateFrom, eventGet.DateTo, eventGet.After.Date, eventGet.After.ID, 11).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "user_id", "calendar_id", "title", "description", "location", "url", "metadata", "date", "end_date",
+			"all_day", "time_zone", "reminders", "rrule", "exdates", "rsvp_status", "tags",
+		}))
+
+	_, err := repo.GetEvents(context.Background(), eventGet)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryInTxCommits(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO event_attendees").
+		WithArgs(uint(1), uint(2)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 2))
+	mock.ExpectCommit()
+
+	err := repo.InTx(context.Background(), func(ctx context.Context) error {
+		return repo.CopyAttendees(ctx, 1, 2)
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryInTxRollsBack(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO event_attendees").
+		WithArgs(uint(1), uint(2)).
+		WillReturnError(pgx.ErrTxClosed)
+	mock.ExpectRollback()
+
+	err := repo.InTx(context.Background(), func(ctx context.Context) error {
+		return repo.CopyAttendees(ctx, 1, 2)
+	})
+	assert.ErrorIs(t, err, pgx.ErrTxClosed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
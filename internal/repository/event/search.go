@@ -0,0 +1,59 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+// SearchEvents returns the user's events matching tsQuery, most relevant
+// first. Events the user sees only as free/busy are not searched. Recurring
+// events are matched by their series and returned once.
+func (r *Repository) SearchEvents(ctx context.Context, search *models.EventSearch, tsQuery string) ([]*models.Event, error) {
+	query := `
+		SELECT e.id, e.user_id, e.calendar_id, e.title, e.description, e.location, e.url, e.metadata,
+		       e.date, e.end_date, e.all_day, e.time_zone, e.reminders, e.rrule, e.exdates, COALESCE(a.status, '')
+		FROM events e
+		CROSS JOIN to_tsquery('simple', $2) q
+		LEFT JOIN calendar_members m ON m.calendar_id = e.calendar_id AND m.user_id = $1
+		LEFT JOIN event_attendees a ON a.event_id = e.id AND a.user_id = $1
+		WHERE e.search @@ q
+		  AND (m.role <> 'freebusy' OR a.user_id IS NOT NULL)
+		  AND ($3::timestamptz IS NULL OR e.rrule <> '' OR e.end_date > $3 OR e.date >= $3)
+		  AND ($4::timestamptz IS NULL OR e.date < $4)
+		ORDER BY ts_rank(e.search, q) DESC, e.date
+		LIMIT $5
+    `
+
+	rows, err := r.conn(ctx).Query(ctx, query, search.UserID, tsQuery, nullTime(search.DateFrom), nullTime(search.DateTo),
+		search.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("repository/SearchEvents - %w", err)
+	}
+	defer rows.Close()
+
+	events := []*models.Event{}
+	for rows.Next() {
+		var e models.Event
+		err := rows.Scan(&e.ID, &e.UserID, &e.CalendarID, &e.Title, &e.Description, &e.Location, &e.URL,
+			&e.Metadata, &e.Date, &e.EndDate, &e.AllDay, &e.TimeZone, &e.Reminders, &e.RRule, &e.ExDates, &e.RSVPStatus)
+		if err != nil {
+			return nil, fmt.Errorf("repository/SearchEvents - %w", err)
+		}
+
+		events = append(events, &e)
+	}
+
+	return events, nil
+}
+
+// nullTime turns a zero time into NULL.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
@@ -0,0 +1,33 @@
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func TestRepositorySearchEvents(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	search := &models.EventSearch{UserID: 1, Query: "team sync", DateFrom: from, Limit: 20}
+
+	mock.ExpectQuery("WHERE e.search @@ q").
+		WithArgs(1, "'team' & 'sync'", &from, (*time.Time)(nil), 20).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "calendar_id", "title", "description", "location", "url",
+			"metadata", "date", "end_date", "all_day", "time_zone", "reminders", "rrule", "exdates", "status"}).
+			AddRow(uint(1), 1, uint(4), "Team sync", "", "Room 4", "", map[string]any{}, from, from.Add(time.Hour), false,
+				"UTC", []int{}, "", []time.Time{}, ""))
+
+	events, err := repo.SearchEvents(context.Background(), search, "'team' & 'sync'")
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "Team sync", events[0].Title)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,146 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+const uniqueViolation = "23505"
+
+func (r *Repository) CreateTag(ctx context.Context, tag *models.Tag) (uint, error) {
+	query := `
+		INSERT INTO tags (user_id, name, color)
+		VALUES ($1, $2, $3)
+		RETURNING id;
+    `
+
+	var ID uint
+	err := r.conn(ctx).QueryRow(ctx, query, tag.UserID, tag.Name, tag.Color).Scan(&ID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, ErrTagExists
+		}
+
+		return 0, fmt.Errorf("repository/CreateTag - %w", err)
+	}
+
+	return ID, nil
+}
+
+func (r *Repository) GetTags(ctx context.Context, userID int) ([]*models.Tag, error) {
+	query := `
+		SELECT id, user_id, name, color
+		FROM tags
+		WHERE user_id = $1
+		ORDER BY lower(name)
+    `
+
+	rows, err := r.conn(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetTags - %w", err)
+	}
+	defer rows.Close()
+
+	tags := []*models.Tag{}
+	for rows.Next() {
+		var t models.Tag
+		if err := rows.Scan(&t.ID, &t.UserID, &t.Name, &t.Color); err != nil {
+			return nil, fmt.Errorf("repository/GetTags - %w", err)
+		}
+
+		tags = append(tags, &t)
+	}
+
+	return tags, nil
+}
+
+func (r *Repository) UpdateTag(ctx context.Context, tag *models.Tag) error {
+	query := `
+		UPDATE tags
+		SET name = $3, color = $4
+		WHERE id = $1 AND user_id = $2;
+    `
+
+	cmdTag, err := r.conn(ctx).Exec(ctx, query, tag.ID, tag.UserID, tag.Name, tag.Color)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrTagExists
+		}
+
+		return fmt.Errorf("repository/UpdateTag - %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrTagNotFound
+	}
+
+	return nil
+}
+
+// DeleteTag deletes the tag and takes it off all events.
+func (r *Repository) DeleteTag(ctx context.Context, userID int, ID uint) error {
+	query := `
+		DELETE FROM tags
+		WHERE id = $1 AND user_id = $2;
+    `
+
+	cmdTag, err := r.conn(ctx).Exec(ctx, query, ID, userID)
+	if err != nil {
+		return fmt.Errorf("repository/DeleteTag - %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrTagNotFound
+	}
+
+	return nil
+}
+
+// SetEventTags replaces the user's tags on the event with the given ones.
+// Tags of other users on the same event are kept. It fails with
+// ErrTagNotFound, changing nothing, if any of the tags is not the user's.
+func (r *Repository) SetEventTags(ctx context.Context, eventTags *models.EventTags) error {
+	query := `
+		WITH requested AS (
+		    SELECT id FROM tags WHERE user_id = $2 AND id = ANY($3)
+		), removed AS (
+		    DELETE FROM event_tags et
+		    USING tags t
+		    WHERE et.event_id = $1 AND et.tag_id = t.id AND t.user_id = $2 AND NOT (t.id = ANY($3))
+		      AND (SELECT count(*) FROM requested) = cardinality($3)
+		), added AS (
+		    INSERT INTO event_tags (event_id, tag_id)
+		    SELECT $1, id FROM requested
+		    WHERE (SELECT count(*) FROM requested) = cardinality($3)
+		    ON CONFLICT (event_id, tag_id) DO NOTHING
+		)
+		SELECT count(*) = cardinality($3) FROM requested;
+    `
+
+	tagIDs := eventTags.TagIDs
+	if tagIDs == nil {
+		tagIDs = []uint{}
+	}
+
+	var found bool
+	err := r.conn(ctx).QueryRow(ctx, query, eventTags.EventID, eventTags.UserID, tagIDs).Scan(&found)
+	if err != nil {
+		return fmt.Errorf("repository/SetEventTags - %w", err)
+	}
+
+	if !found {
+		return ErrTagNotFound
+	}
+
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
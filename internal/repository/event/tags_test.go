@@ -0,0 +1,123 @@
+package event
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func TestRepositoryCreateTag(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("INSERT INTO tags").
+		WithArgs(1, "Work", "#ff0000").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(uint(3)))
+
+	ID, err := repo.CreateTag(context.Background(), &models.Tag{UserID: 1, Name: "Work", Color: "#ff0000"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3), ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryCreateTagExists(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("INSERT INTO tags").
+		WithArgs(1, "work", "").
+		WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+
+	_, err := repo.CreateTag(context.Background(), &models.Tag{UserID: 1, Name: "work"})
+	assert.ErrorIs(t, err, ErrTagExists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetTags(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("SELECT id, user_id, name, color FROM tags").
+		WithArgs(1).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "name", "color"}).
+			AddRow(uint(2), 1, "Home", "").
+			AddRow(uint(1), 1, "work", "#00ff00"))
+
+	tags, err := repo.GetTags(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Tag{
+		{ID: 2, UserID: 1, Name: "Home"},
+		{ID: 1, UserID: 1, Name: "work", Color: "#00ff00"},
+	}, tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryUpdateTagNotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("UPDATE tags").
+		WithArgs(uint(7), 1, "Work", "").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err := repo.UpdateTag(context.Background(), &models.Tag{ID: 7, UserID: 1, Name: "Work"})
+	assert.ErrorIs(t, err, ErrTagNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryDeleteTag(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("DELETE FROM tags").
+		WithArgs(uint(7), 1).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+	err := repo.DeleteTag(context.Background(), 1, 7)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositorySetEventTags(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("WITH requested AS").
+		WithArgs(uint(4), 1, []uint{1, 2}).
+		WillReturnRows(pgxmock.NewRows([]string{"found"}).AddRow(true))
+
+	err := repo.SetEventTags(context.Background(), &models.EventTags{EventID: 4, UserID: 1, TagIDs: []uint{1, 2}})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositorySetEventTagsForeignTag(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("WITH requested AS").
+		WithArgs(uint(4), 1, []uint{9}).
+		WillReturnRows(pgxmock.NewRows([]string{"found"}).AddRow(false))
+
+	err := repo.SetEventTags(context.Background(), &models.EventTags{EventID: 4, UserID: 1, TagIDs: []uint{9}})
+	assert.ErrorIs(t, err, ErrTagNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositorySetEventTagsClear(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("WITH requested AS").
+		WithArgs(uint(4), 1, []uint{}).
+		WillReturnRows(pgxmock.NewRows([]string{"found"}).AddRow(true))
+
+	err := repo.SetEventTags(context.Background(), &models.EventTags{EventID: 4, UserID: 1})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,89 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+var (
+	ErrTokenNotFound = errors.New("feed token not found")
+)
+
+type DB interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, optionsAndArgs ...any) pgx.Row
+}
+
+type Repository struct {
+	db DB
+}
+
+func New(db DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// SaveToken sets the user's feed token, replacing and thereby revoking the
+// previous one.
+func (r *Repository) SaveToken(ctx context.Context, userID int, token string) error {
+	query := `
+		INSERT INTO feed_tokens (user_id, token)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET token = EXCLUDED.token, created_at = now();
+    `
+
+	_, err := r.db.Exec(ctx, query, userID, token)
+	if err != nil {
+		return fmt.Errorf("repository/SaveToken - %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteToken(ctx context.Context, userID int) error {
+	query := `
+		DELETE FROM feed_tokens
+		WHERE user_id = $1;
+    `
+
+	cmdTag, err := r.db.Exec(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("repository/DeleteToken - %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrTokenNotFound
+	}
+
+	return nil
+}
+
+// GetFeed resolves a token to its user and the time the user's events last
+// changed. Users whose events never changed report the token creation time.
+func (r *Repository) GetFeed(ctx context.Context, token string) (*models.Feed, error) {
+	query := `
+		SELECT t.user_id, COALESCE(m.modified_at, t.created_at)
+		FROM feed_tokens t
+		LEFT JOIN event_modifications m ON m.user_id = t.user_id
+		WHERE t.token = $1;
+    `
+
+	var feed models.Feed
+	err := r.db.QueryRow(ctx, query, token).Scan(&feed.UserID, &feed.ModifiedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTokenNotFound
+		}
+
+		return nil, fmt.Errorf("repository/GetFeed - %w", err)
+	}
+
+	return &feed, nil
+}
@@ -0,0 +1,62 @@
+package feed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepositoryGetFeed(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	modifiedAt := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT (.+) FROM feed_tokens").
+		WithArgs("secret").
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "modified_at"}).AddRow(1, modifiedAt))
+
+	feed, err := repo.GetFeed(context.Background(), "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, feed.UserID)
+	assert.Equal(t, modifiedAt, feed.ModifiedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryGetFeedNotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM feed_tokens").
+		WithArgs("revoked").
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetFeed(context.Background(), "revoked")
+	assert.ErrorIs(t, err, ErrTokenNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryDeleteTokenNotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("DELETE FROM feed_tokens").
+		WithArgs(1).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err := repo.DeleteToken(context.Background(), 1)
+	assert.ErrorIs(t, err, ErrTokenNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
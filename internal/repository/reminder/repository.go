@@ -0,0 +1,75 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+type DB interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, optionsAndArgs ...any) (pgx.Rows, error)
+}
+
+type Repository struct {
+	db DB
+}
+
+func New(db DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// GetEventsWithReminders returns events with reminders that start inside
+// [from, to) and every recurring series with reminders that started before to.
+func (r *Repository) GetEventsWithReminders(ctx context.Context, from, to time.Time) ([]*models.Event, error) {
+	query := `
+		SELECT id, user_id, title, date, end_date, all_day, time_zone, reminders, rrule, exdates
+		FROM events
+		WHERE cardinality(reminders) > 0 AND date < $2 AND (rrule <> '' OR date >= $1)
+    `
+
+	rows, err := r.db.Query(ctx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetEventsWithReminders - %w", err)
+	}
+	defer rows.Close()
+
+	events := []*models.Event{}
+	for rows.Next() {
+		var e models.Event
+		err := rows.Scan(&e.ID, &e.UserID, &e.Title, &e.Date, &e.EndDate, &e.AllDay, &e.TimeZone, &e.Reminders,
+			&e.RRule, &e.ExDates)
+		if err != nil {
+			return nil, fmt.Errorf("repository/GetEventsWithReminders - %w", err)
+		}
+
+		events = append(events, &e)
+	}
+
+	return events, nil
+}
+
+// MarkSent records the delivery of a reminder and reports whether it was
+// recorded by this call, so each reminder is dispatched at most once.
+func (r *Repository) MarkSent(ctx context.Context, notification *models.Notification) (bool, error) {
+	query := `
+		INSERT INTO reminder_deliveries (
+		    event_id, occurrence, minutes_before
+		) VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING;
+    `
+
+	cmdTag, err := r.db.Exec(ctx, query, notification.EventID, notification.Date, notification.MinutesBefore)
+	if err != nil {
+		return false, fmt.Errorf("repository/MarkSent - %w", err)
+	}
+
+	return cmdTag.RowsAffected() == 1, nil
+}
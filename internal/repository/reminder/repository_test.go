@@ -0,0 +1,36 @@
+package reminder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepositoryMarkSentAlreadySent(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	notification := &models.Notification{EventID: 1, Date: time.Now(), MinutesBefore: 10}
+
+	mock.ExpectExec("INSERT INTO reminder_deliveries").
+		WithArgs(notification.EventID, notification.Date, notification.MinutesBefore).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+	ok, err := repo.MarkSent(context.Background(), notification)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
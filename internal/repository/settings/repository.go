@@ -0,0 +1,159 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+var (
+	ErrOutOfOfficeNotFound = errors.New("out-of-office period not found")
+)
+
+type DB interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, optionsAndArgs ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, optionsAndArgs ...any) pgx.Row
+}
+
+type Repository struct {
+	db DB
+}
+
+func New(db DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// GetSettings returns the user's settings, or the defaults when the user has
+// not saved any.
+func (r *Repository) GetSettings(ctx context.Context, userID int) (*models.UserSettings, error) {
+	query := `
+		SELECT time_zone, working_hours
+		FROM user_settings
+		WHERE user_id = $1;
+    `
+
+	settings := &models.UserSettings{UserID: userID}
+	err := r.db.QueryRow(ctx, query, userID).Scan(&settings.TimeZone, &settings.WorkingHours)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &models.UserSettings{
+				UserID:       userID,
+				TimeZone:     time.UTC.String(),
+				WorkingHours: []models.WorkingHours{},
+			}, nil
+		}
+
+		return nil, fmt.Errorf("repository/GetSettings - %w", err)
+	}
+
+	return settings, nil
+}
+
+func (r *Repository) SaveSettings(ctx context.Context, settings *models.UserSettings) error {
+	query := `
+		INSERT INTO user_settings (user_id, time_zone, working_hours)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE
+		SET time_zone = EXCLUDED.time_zone, working_hours = EXCLUDED.working_hours, updated_at = now();
+    `
+
+	workingHours := settings.WorkingHours
+	if workingHours == nil {
+		workingHours = []models.WorkingHours{}
+	}
+
+	_, err := r.db.Exec(ctx, query, settings.UserID, settings.TimeZone, workingHours)
+	if err != nil {
+		return fmt.Errorf("repository/SaveSettings - %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSettings resets the user's settings to the defaults. Out-of-office
+// periods are kept.
+func (r *Repository) DeleteSettings(ctx context.Context, userID int) error {
+	query := `
+		DELETE FROM user_settings
+		WHERE user_id = $1;
+    `
+
+	_, err := r.db.Exec(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("repository/DeleteSettings - %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateOutOfOffice(ctx context.Context, ooo *models.OutOfOffice) (uint, error) {
+	query := `
+		INSERT INTO out_of_office (user_id, start_at, end_at, reason)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id;
+    `
+
+	var ID uint
+	err := r.db.QueryRow(ctx, query, ooo.UserID, ooo.Start, ooo.End, ooo.Reason).Scan(&ID)
+	if err != nil {
+		return 0, fmt.Errorf("repository/CreateOutOfOffice - %w", err)
+	}
+
+	return ID, nil
+}
+
+// GetOutOfOffice returns the user's out-of-office periods that have not ended
+// yet.
+func (r *Repository) GetOutOfOffice(ctx context.Context, userID int) ([]*models.OutOfOffice, error) {
+	query := `
+		SELECT id, user_id, start_at, end_at, reason
+		FROM out_of_office
+		WHERE user_id = $1 AND end_at > now()
+		ORDER BY start_at
+    `
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("repository/GetOutOfOffice - %w", err)
+	}
+	defer rows.Close()
+
+	periods := []*models.OutOfOffice{}
+	for rows.Next() {
+		var o models.OutOfOffice
+		if err := rows.Scan(&o.ID, &o.UserID, &o.Start, &o.End, &o.Reason); err != nil {
+			return nil, fmt.Errorf("repository/GetOutOfOffice - %w", err)
+		}
+
+		periods = append(periods, &o)
+	}
+
+	return periods, nil
+}
+
+func (r *Repository) DeleteOutOfOffice(ctx context.Context, userID int, ID uint) error {
+	query := `
+		DELETE FROM out_of_office
+		WHERE id = $1 AND user_id = $2;
+    `
+
+	cmdTag, err := r.db.Exec(ctx, query, ID, userID)
+	if err != nil {
+		return fmt.Errorf("repository/DeleteOutOfOffice - %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrOutOfOfficeNotFound
+	}
+
+	return nil
+}
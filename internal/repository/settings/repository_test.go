@@ -0,0 +1,78 @@
+package settings
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func newTestRepo(t *testing.T) (*Repository, pgxmock.PgxPoolIface) {
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create pgxmock: %v", err)
+	}
+	return New(mock), mock
+}
+
+func TestRepositoryGetSettingsDefaults(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectQuery("SELECT time_zone, working_hours FROM user_settings").
+		WithArgs(1).
+		WillReturnError(pgx.ErrNoRows)
+
+	settings, err := repo.GetSettings(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.UserSettings{UserID: 1, TimeZone: "UTC", WorkingHours: []models.WorkingHours{}}, settings)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositorySaveSettings(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	settings := &models.UserSettings{UserID: 1, TimeZone: "Europe/Moscow"}
+	mock.ExpectExec("INSERT INTO user_settings").
+		WithArgs(1, "Europe/Moscow", []models.WorkingHours{}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	err := repo.SaveSettings(context.Background(), settings)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryCreateOutOfOffice(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	ooo := &models.OutOfOffice{UserID: 1, Start: start, End: start.AddDate(0, 0, 5), Reason: "vacation"}
+	mock.ExpectQuery("INSERT INTO out_of_office").
+		WithArgs(ooo.UserID, ooo.Start, ooo.End, ooo.Reason).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(uint(3)))
+
+	ID, err := repo.CreateOutOfOffice(context.Background(), ooo)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3), ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepositoryDeleteOutOfOfficeNotFound(t *testing.T) {
+	repo, mock := newTestRepo(t)
+	defer mock.Close()
+
+	mock.ExpectExec("DELETE FROM out_of_office").
+		WithArgs(uint(3), 1).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err := repo.DeleteOutOfOffice(context.Background(), 1, 3)
+	assert.ErrorIs(t, err, ErrOutOfOfficeNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,92 @@
+//go:build unit
+// +build unit
+
+package apikey
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	apiKeyM "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	apiKeyR "github.com/avraam311/calendar-service/internal/repository/apikey"
+)
+
+func TestServiceCreateKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := apiKeyM.NewMockapiKeyRepo(ctrl)
+	svc := New(mockRepo)
+
+	var hash string
+	mockRepo.EXPECT().
+		CreateKey(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key *models.APIKey, h string) (*models.APIKey, error) {
+			hash = h
+			created := *key
+			created.ID = 3
+			return &created, nil
+		})
+
+	issued, err := svc.CreateKey(context.Background(), &models.APIKeyCreate{
+		UserID: 1, Name: "batch", Scopes: []string{models.APIKeyScopeRead},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(issued.Key, keyPrefix) || !strings.HasPrefix(issued.Key, issued.Prefix) {
+		t.Fatalf("unexpected key %q with prefix %q", issued.Key, issued.Prefix)
+	}
+	if hash != hashKey(issued.Key) || strings.Contains(hash, issued.Key) {
+		t.Fatalf("expected the key hash to be stored, got %q", hash)
+	}
+
+	mockRepo.EXPECT().UseKey(gomock.Any(), hash).Return(&issued.APIKey, nil)
+
+	key, err := svc.Authenticate(context.Background(), issued.Key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key.ID != 3 {
+		t.Fatalf("expected key 3, got %d", key.ID)
+	}
+}
+
+func TestServiceAuthenticateUnknownFormat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(apiKeyM.NewMockapiKeyRepo(ctrl))
+
+	_, err := svc.Authenticate(context.Background(), "not-a-key")
+	if !errors.Is(err, apiKeyR.ErrKeyNotFound) {
+		t.Fatalf("expected ErrKeyNotFound, got %v", err)
+	}
+}
+
+func TestServiceRotateKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := apiKeyM.NewMockapiKeyRepo(ctrl)
+	svc := New(mockRepo)
+
+	mockRepo.EXPECT().
+		RotateKey(gomock.Any(), 1, uint(3), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, userID int, ID uint, prefix, _ string) (*models.APIKey, error) {
+			return &models.APIKey{ID: ID, UserID: userID, Prefix: prefix}, nil
+		})
+
+	issued, err := svc.RotateKey(context.Background(), 1, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(issued.Key, issued.Prefix) {
+		t.Fatalf("unexpected key %q with prefix %q", issued.Key, issued.Prefix)
+	}
+}
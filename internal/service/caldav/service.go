@@ -0,0 +1,106 @@
+package caldav
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/avraam311/calendar-service/internal/models"
+	eventS "github.com/avraam311/calendar-service/internal/service/event"
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/mock_caldav_service.go -package=mocks
+type caldavRepo interface {
+	GetEvents(ctx context.Context, userID int) ([]*models.Event, error)
+	GetEventByUID(ctx context.Context, userID int, UID string) (*models.Event, error)
+	GetOverrides(ctx context.Context, userID int, UID string) ([]*models.Event, error)
+	GetSyncToken(ctx context.Context, userID int) (int64, error)
+	GetChanges(ctx context.Context, userID int, since, until int64) ([]*models.EventChange, error)
+}
+
+type Service struct {
+	caldavRepo caldavRepo
+}
+
+func New(r caldavRepo) *Service {
+	return &Service{
+		caldavRepo: r,
+	}
+}
+
+func (s *Service) GetEvents(ctx context.Context, userID int) ([]*models.Event, error) {
+	events, err := s.caldavRepo.GetEvents(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetEvents - %w", err)
+	}
+
+	return events, nil
+}
+
+func (s *Service) GetEvent(ctx context.Context, userID int, UID string) (*models.Event, error) {
+	event, err := s.caldavRepo.GetEventByUID(ctx, userID, UID)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetEvent - %w", err)
+	}
+
+	return event, nil
+}
+
+// GetOverrides returns the overridden occurrences of the series with the UID.
+func (s *Service) GetOverrides(ctx context.Context, userID int, UID string) ([]*models.Event, error) {
+	events, err := s.caldavRepo.GetOverrides(ctx, userID, UID)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetOverrides - %w", err)
+	}
+
+	return events, nil
+}
+
+// QueryEvents returns the stored events that have an occurrence overlapping
+// [from, to). Series are returned whole, not expanded.
+func (s *Service) QueryEvents(ctx context.Context, userID int, from, to time.Time) ([]*models.Event, error) {
+	events, err := s.caldavRepo.GetEvents(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("service/QueryEvents - %w", err)
+	}
+
+	result := make([]*models.Event, 0, len(events))
+	for _, e := range events {
+		occurrence := *e
+		occurrences, err := eventS.Expand(&occurrence, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("service/QueryEvents - %w", err)
+		}
+
+		if len(occurrences) > 0 {
+			result = append(result, e)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetSyncToken(ctx context.Context, userID int) (int64, error) {
+	token, err := s.caldavRepo.GetSyncToken(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("service/GetSyncToken - %w", err)
+	}
+
+	return token, nil
+}
+
+// GetChanges returns what changed in the user's calendar after the since
+// token together with the token the client should continue from.
+func (s *Service) GetChanges(ctx context.Context, userID int, since int64) ([]*models.EventChange, int64, error) {
+	token, err := s.caldavRepo.GetSyncToken(ctx, userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("service/GetChanges - %w", err)
+	}
+
+	changes, err := s.caldavRepo.GetChanges(ctx, userID, since, token)
+	if err != nil {
+		return nil, 0, fmt.Errorf("service/GetChanges - %w", err)
+	}
+
+	return changes, token, nil
+}
@@ -0,0 +1,41 @@
+//go:build unit
+// +build unit
+
+package caldav
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	caldavR "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func TestServiceQueryEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := caldavR.NewMockcaldavRepo(ctrl)
+	svc := New(mockRepo)
+
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	events := []*models.Event{
+		{ID: 1, Date: start, EndDate: start.Add(time.Hour), TimeZone: "UTC", RRule: "FREQ=WEEKLY"},
+		{ID: 2, Date: start, EndDate: start.Add(time.Hour), TimeZone: "UTC"},
+		{ID: 3, Date: start.AddDate(0, 1, 0), EndDate: start.AddDate(0, 1, 0), TimeZone: "UTC"},
+	}
+
+	mockRepo.EXPECT().GetEvents(gomock.Any(), 1).Return(events, nil)
+
+	from := time.Date(2026, 1, 19, 0, 0, 0, 0, time.UTC)
+	got, err := svc.QueryEvents(context.Background(), 1, from, from.AddDate(0, 0, 7))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != 1 || got[0].RecurrenceID != nil {
+		t.Fatalf("expected the unexpanded series, got %+v", got)
+	}
+}
@@ -0,0 +1,108 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+var (
+	ErrForbidden = errors.New("only calendar owners can manage access")
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/mock_calendar_service.go -package=mocks
+type calendarRepo interface {
+	CreateCalendar(ctx context.Context, calendar *models.CalendarCreate) (uint, error)
+	GetCalendars(ctx context.Context, userID int) ([]*models.Calendar, error)
+	GetRole(ctx context.Context, calendarID uint, userID int) (string, error)
+	GetMembers(ctx context.Context, calendarID uint) ([]*models.CalendarMember, error)
+	SetMember(ctx context.Context, member *models.CalendarMember) error
+	DeleteMember(ctx context.Context, calendarID uint, userID int) error
+}
+
+type Service struct {
+	calendarRepo calendarRepo
+}
+
+func New(r calendarRepo) *Service {
+	return &Service{
+		calendarRepo: r,
+	}
+}
+
+func (s *Service) CreateCalendar(ctx context.Context, calendar *models.CalendarCreate) (uint, error) {
+	ID, err := s.calendarRepo.CreateCalendar(ctx, calendar)
+	if err != nil {
+		return 0, fmt.Errorf("service/CreateCalendar - %w", err)
+	}
+
+	return ID, nil
+}
+
+func (s *Service) GetCalendars(ctx context.Context, userID int) ([]*models.Calendar, error) {
+	calendars, err := s.calendarRepo.GetCalendars(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetCalendars - %w", err)
+	}
+
+	return calendars, nil
+}
+
+// GetMembers lists who has access to the calendar; any member may see it.
+func (s *Service) GetMembers(ctx context.Context, userID int, calendarID uint) ([]*models.CalendarMember, error) {
+	if _, err := s.calendarRepo.GetRole(ctx, calendarID, userID); err != nil {
+		return nil, fmt.Errorf("service/GetMembers - %w", err)
+	}
+
+	members, err := s.calendarRepo.GetMembers(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetMembers - %w", err)
+	}
+
+	return members, nil
+}
+
+// ShareCalendar grants a user a role in the calendar, or changes it. Only
+// owners can share, and the last owner cannot be demoted.
+func (s *Service) ShareCalendar(ctx context.Context, userID int, member *models.CalendarMember) error {
+	if err := s.requireOwner(ctx, member.CalendarID, userID); err != nil {
+		return fmt.Errorf("service/ShareCalendar - %w", err)
+	}
+
+	if err := s.calendarRepo.SetMember(ctx, member); err != nil {
+		return fmt.Errorf("service/ShareCalendar - %w", err)
+	}
+
+	return nil
+}
+
+// RevokeAccess removes a member from the calendar. Owners can remove anyone
+// but the last owner, other members only themselves.
+func (s *Service) RevokeAccess(ctx context.Context, userID int, calendarID uint, memberID int) error {
+	if memberID != userID {
+		if err := s.requireOwner(ctx, calendarID, userID); err != nil {
+			return fmt.Errorf("service/RevokeAccess - %w", err)
+		}
+	}
+
+	if err := s.calendarRepo.DeleteMember(ctx, calendarID, memberID); err != nil {
+		return fmt.Errorf("service/RevokeAccess - %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) requireOwner(ctx context.Context, calendarID uint, userID int) error {
+	role, err := s.calendarRepo.GetRole(ctx, calendarID, userID)
+	if err != nil {
+		return err
+	}
+
+	if role != models.CalendarRoleOwner {
+		return ErrForbidden
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+//go:build unit
+// +build unit
+
+package calendar
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	calendarM "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	calendarR "github.com/avraam311/calendar-service/internal/repository/calendar"
+)
+
+func TestServiceShareCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := calendarM.NewMockcalendarRepo(ctrl)
+	svc := New(mockRepo)
+
+	member := &models.CalendarMember{CalendarID: 7, UserID: 2, Role: models.CalendarRoleViewer}
+	mockRepo.EXPECT().GetRole(gomock.Any(), uint(7), 1).Return(models.CalendarRoleOwner, nil)
+	mockRepo.EXPECT().SetMember(gomock.Any(), member).Return(nil)
+
+	if err := svc.ShareCalendar(context.Background(), 1, member); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestServiceShareCalendarNotOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := calendarM.NewMockcalendarRepo(ctrl)
+	svc := New(mockRepo)
+
+	mockRepo.EXPECT().GetRole(gomock.Any(), uint(7), 1).Return(models.CalendarRoleEditor, nil)
+
+	err := svc.ShareCalendar(context.Background(), 1,
+		&models.CalendarMember{CalendarID: 7, UserID: 2, Role: models.CalendarRoleEditor})
+	if !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestServiceDemoteLastOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := calendarM.NewMockcalendarRepo(ctrl)
+	svc := New(mockRepo)
+
+	member := &models.CalendarMember{CalendarID: 7, UserID: 1, Role: models.CalendarRoleViewer}
+	mockRepo.EXPECT().GetRole(gomock.Any(), uint(7), 1).Return(models.CalendarRoleOwner, nil)
+	mockRepo.EXPECT().SetMember(gomock.Any(), member).Return(calendarR.ErrLastOwner)
+
+	err := svc.ShareCalendar(context.Background(), 1, member)
+	if !errors.Is(err, calendarR.ErrLastOwner) {
+		t.Fatalf("expected ErrLastOwner, got %v", err)
+	}
+}
+
+func TestServiceRevokeAccessLeave(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := calendarM.NewMockcalendarRepo(ctrl)
+	svc := New(mockRepo)
+
+	mockRepo.EXPECT().DeleteMember(gomock.Any(), uint(7), 2).Return(nil)
+
+	if err := svc.RevokeAccess(context.Background(), 2, 7, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package event
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+// InviteAttendees invites users to the event on behalf of invite.UserID, who
+// must be able to edit it, and returns the event's attendees.
+func (s *Service) InviteAttendees(ctx context.Context, invite *models.EventInvite) ([]*models.Attendee, error) {
+	if _, err := s.eventRepo.GetEvent(ctx, invite.UserID, invite.EventID); err != nil {
+		return nil, fmt.Errorf("service/InviteAttendees - %w", err)
+	}
+
+	if err := s.eventRepo.AddAttendees(ctx, invite.EventID, invite.UserIDs); err != nil {
+		return nil, fmt.Errorf("service/InviteAttendees - %w", err)
+	}
+
+	attendees, err := s.eventRepo.GetAttendees(ctx, invite.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("service/InviteAttendees - %w", err)
+	}
+
+	return attendees, nil
+}
+
+// GetAttendees lists the event's attendees to its attendees and to the users
+// who can edit it.
+func (s *Service) GetAttendees(ctx context.Context, userID int, eventID uint) ([]*models.Attendee, error) {
+	attendees, err := s.eventRepo.GetAttendees(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetAttendees - %w", err)
+	}
+
+	for _, a := range attendees {
+		if a.UserID == userID {
+			return attendees, nil
+		}
+	}
+
+	if _, err = s.eventRepo.GetEvent(ctx, userID, eventID); err != nil {
+		return nil, fmt.Errorf("service/GetAttendees - %w", err)
+	}
+
+	return attendees, nil
+}
+
+func (s *Service) RespondToEvent(ctx context.Context, rsvp *models.EventRSVP) error {
+	if err := s.eventRepo.SetAttendeeStatus(ctx, rsvp); err != nil {
+		return fmt.Errorf("service/RespondToEvent - %w", err)
+	}
+
+	return nil
+}
+
+// RemoveAttendee withdraws an invitation. Users who can edit the event can
+// remove anyone, attendees only themselves.
+func (s *Service) RemoveAttendee(ctx context.Context, userID int, eventID uint, attendeeID int) error {
+	if attendeeID != userID {
+		if _, err := s.eventRepo.GetEvent(ctx, userID, eventID); err != nil {
+			return fmt.Errorf("service/RemoveAttendee - %w", err)
+		}
+	}
+
+	if err := s.eventRepo.DeleteAttendee(ctx, eventID, attendeeID); err != nil {
+		return fmt.Errorf("service/RemoveAttendee - %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+//go:build unit
+// +build unit
+
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	eventR "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	eventRepository "github.com/avraam311/calendar-service/internal/repository/event"
+)
+
+func TestServiceInviteAttendees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	attendees := []*models.Attendee{{UserID: 2, Status: models.AttendeeNeedsAction}}
+	mockRepo.EXPECT().GetEvent(gomock.Any(), 1, uint(7)).Return(&models.Event{ID: 7}, nil)
+	mockRepo.EXPECT().AddAttendees(gomock.Any(), uint(7), []int{2}).Return(nil)
+	mockRepo.EXPECT().GetAttendees(gomock.Any(), uint(7)).Return(attendees, nil)
+
+	got, err := svc.InviteAttendees(context.Background(), &models.EventInvite{EventID: 7, UserID: 1, UserIDs: []int{2}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].UserID != 2 {
+		t.Fatalf("unexpected attendees: %v", got)
+	}
+}
+
+func TestServiceInviteAttendeesForbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	mockRepo.EXPECT().GetEvent(gomock.Any(), 2, uint(7)).Return(nil, eventRepository.ErrForbidden)
+
+	_, err := svc.InviteAttendees(context.Background(), &models.EventInvite{EventID: 7, UserID: 2, UserIDs: []int{3}})
+	if !errors.Is(err, eventRepository.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestServiceGetAttendeesAsAttendee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	attendees := []*models.Attendee{{UserID: 2, Status: models.AttendeeAccepted}}
+	mockRepo.EXPECT().GetAttendees(gomock.Any(), uint(7)).Return(attendees, nil)
+
+	if _, err := svc.GetAttendees(context.Background(), 2, 7); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestServiceRemoveAttendeeSelf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	mockRepo.EXPECT().DeleteAttendee(gomock.Any(), uint(7), 2).Return(nil)
+
+	if err := svc.RemoveAttendee(context.Background(), 2, 7, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+// availability tells when a user can be scheduled. A nil availability, like
+// one without working hours, means the user is available around the clock.
+type availability struct {
+	loc         *time.Location
+	hours       []*workingHours
+	outOfOffice []models.OutOfOffice
+}
+
+// availability loads the availability of the users within [from, to), keyed
+// by user ID.
+func (s *Service) availability(ctx context.Context, userIDs []int, from, to time.Time) (map[int]*availability, error) {
+	rules, err := s.eventRepo.GetAvailability(ctx, userIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]*availability, len(rules))
+	for _, r := range rules {
+		a := &availability{loc: location(r.TimeZone), outOfOffice: r.OutOfOffice}
+		for i := range r.WorkingHours {
+			// Working hours are validated when saved.
+			if hours, err := parseWorkingHours(&r.WorkingHours[i]); err == nil {
+				a.hours = append(a.hours, hours)
+			}
+		}
+		result[r.UserID] = a
+	}
+
+	return result, nil
+}
+
+// available reports whether the user works during all of [start, end) and is
+// not away.
+func (a *availability) available(start, end time.Time) bool {
+	return !a.outsideWorkingHours(start, end) && !a.away(start, end)
+}
+
+// outsideWorkingHours reports whether [start, end) does not fit into the
+// working hours of a single day.
+func (a *availability) outsideWorkingHours(start, end time.Time) bool {
+	if a == nil || len(a.hours) == 0 {
+		return false
+	}
+
+	start, end = start.In(a.loc), end.In(a.loc)
+	for _, h := range a.hours {
+		if h.contain(start, end) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// away reports whether [start, end) overlaps an out-of-office period.
+func (a *availability) away(start, end time.Time) bool {
+	if a == nil {
+		return false
+	}
+
+	for _, o := range a.outOfOffice {
+		if overlaps(start, end, o.Start, o.End) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// warnings describes the occurrences the user is not available for.
+func (a *availability) warnings(occurrences []*models.Event) []*models.Warning {
+	var outside, away int
+	for _, o := range occurrences {
+		if a.outsideWorkingHours(o.Date, o.EndDate) {
+			outside++
+		}
+		if a.away(o.Date, o.EndDate) {
+			away++
+		}
+	}
+
+	var warnings []*models.Warning
+	if outside > 0 {
+		warnings = append(warnings, &models.Warning{
+			Code:    models.WarningOutsideWorkingHours,
+			Message: occurrencesMessage(outside, len(occurrences), "outside working hours"),
+		})
+	}
+	if away > 0 {
+		warnings = append(warnings, &models.Warning{
+			Code:    models.WarningOutOfOffice,
+			Message: occurrencesMessage(away, len(occurrences), "during an out-of-office period"),
+		})
+	}
+
+	return warnings
+}
+
+func occurrencesMessage(n, total int, what string) string {
+	if total == 1 {
+		return "event is " + what
+	}
+
+	return fmt.Sprintf("%d of %d occurrences are %s", n, total, what)
+}
@@ -0,0 +1,122 @@
+//go:build unit
+// +build unit
+
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	eventR "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func TestServiceGetEventsFlagsOutsideWorkingHours(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	getData := &models.EventGet{UserID: 1, DateFrom: day, DateTo: day.AddDate(0, 0, 1)}
+
+	mockRepo.EXPECT().GetEvents(gomock.Any(), getData).Return([]*models.Event{
+		{ID: 1, UserID: 1, Date: day.Add(7 * time.Hour), EndDate: day.Add(8 * time.Hour), TimeZone: "UTC"},
+		{ID: 2, UserID: 1, Date: day.Add(16 * time.Hour), EndDate: day.Add(17 * time.Hour), TimeZone: "UTC"},
+		{ID: 3, UserID: 1, Date: day, EndDate: day.AddDate(0, 0, 1), AllDay: true, TimeZone: "UTC"},
+	}, nil)
+	// 10:00-18:00 in Moscow is 07:00-15:00 UTC.
+	mockRepo.EXPECT().GetAvailability(gomock.Any(), []int{1}, getData.DateFrom, getData.DateTo).
+		Return([]*models.Availability{{
+			UserID:       1,
+			TimeZone:     "Europe/Moscow",
+			WorkingHours: []models.WorkingHours{{Start: "10:00", End: "18:00"}},
+		}}, nil)
+
+	events, err := svc.GetEvents(context.Background(), getData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	flags := map[uint]bool{}
+	for _, e := range events {
+		flags[e.ID] = e.OutsideWorkingHours
+	}
+	if flags[1] || !flags[2] || flags[3] {
+		t.Fatalf("unexpected flags %v", flags)
+	}
+}
+
+func TestServiceCreateEventWarnsAboutAvailability(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	start := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+	ev := &models.EventCreate{UserID: 1, Title: "Review", Date: start, EndDate: start.Add(time.Hour)}
+
+	mockRepo.EXPECT().WritableCalendar(gomock.Any(), 1, uint(0)).Return(uint(4), nil)
+	mockRepo.EXPECT().GetEvents(gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockRepo.EXPECT().GetAvailability(gomock.Any(), []int{1}, start, start.Add(time.Hour)).
+		Return([]*models.Availability{{
+			UserID:   1,
+			TimeZone: "UTC",
+			WorkingHours: []models.WorkingHours{
+				{Start: "09:00", End: "18:00", Days: []string{"monday", "tuesday", "wednesday", "thursday", "friday"}},
+			},
+			OutOfOffice: []models.OutOfOffice{{UserID: 1, Start: start.AddDate(0, 0, -1), End: start.AddDate(0, 0, 2)}},
+		}}, nil)
+	mockRepo.EXPECT().CreateEvent(gomock.Any(), ev).Return(uint(9), nil)
+
+	_, warnings, err := svc.CreateEvent(context.Background(), ev)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 7 March 2026 is a Saturday.
+	if len(warnings) != 2 || warnings[0].Code != models.WarningOutsideWorkingHours ||
+		warnings[1].Code != models.WarningOutOfOffice {
+		t.Fatalf("unexpected warnings %v", warnings)
+	}
+}
+
+func TestServiceFindSlotsSkipsOutOfOffice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.EXPECT().GetEvents(gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockRepo.EXPECT().GetAvailability(gomock.Any(), []int{2}, day, day.AddDate(0, 0, 2)).
+		Return([]*models.Availability{{
+			UserID:       2,
+			TimeZone:     "UTC",
+			WorkingHours: []models.WorkingHours{{Start: "12:00", End: "16:00"}},
+			OutOfOffice:  []models.OutOfOffice{{UserID: 2, Start: day, End: day.AddDate(0, 0, 1)}},
+		}}, nil)
+
+	slots, err := svc.FindSlots(context.Background(), &models.SlotQuery{
+		UserID:   2,
+		UserIDs:  []int{2},
+		Duration: 60,
+		DateFrom: day,
+		DateTo:   day.AddDate(0, 0, 2),
+		Limit:    1,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(slots) != 1 || !slots[0].Start.Equal(day.AddDate(0, 0, 1).Add(12*time.Hour)) {
+		t.Fatalf("unexpected slots %v", slots)
+	}
+}
@@ -0,0 +1,138 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+// conflictHorizonMonths bounds the conflict check of a recurring event: only
+// its occurrences within this many months of its start are checked, so an
+// endless series does not have to be expanded forever.
+const conflictHorizonMonths = 12
+
+// ConflictError rejects an event that overlaps events the user takes part in.
+type ConflictError struct {
+	Events []*models.Event
+}
+
+func (e *ConflictError) Error() string {
+	return "event conflicts with existing events"
+}
+
+// checkConflicts warns about the user's events that overlap the candidate and
+// about occurrences outside the user's working hours or during their absence.
+// Overlapping events are returned as a ConflictError instead when the caller
+// asked to reject conflicting changes. Occurrences are checked within
+// conflictHorizonMonths of the candidate's start; free candidates are not
+// checked at all.
+func (s *Service) checkConflicts(ctx context.Context, userID int, candidate *models.Event,
+	onConflict string) ([]*models.Warning, error) {
+	if isFree(candidate) {
+		return nil, nil
+	}
+
+	to := candidate.EndDate
+	if candidate.RRule != "" {
+		to = candidate.Date.AddDate(0, conflictHorizonMonths, 0)
+	}
+
+	c := *candidate
+	occurrences, err := Expand(&c, candidate.Date, to)
+	if err != nil || len(occurrences) == 0 {
+		return nil, err
+	}
+	from, to := occurrences[0].Date, occurrences[len(occurrences)-1].EndDate
+
+	conflicts, err := s.conflicts(ctx, userID, candidate.ID, occurrences, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(conflicts) > 0 && onConflict == models.ConflictReject {
+		return nil, &ConflictError{Events: conflicts}
+	}
+
+	availability, err := s.availability(ctx, []int{userID}, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings := make([]*models.Warning, 0, len(conflicts))
+	for _, e := range conflicts {
+		message := fmt.Sprintf("overlaps event %d", e.ID)
+		if candidate.RRule != "" {
+			message += fmt.Sprintf(" (occurrences are checked %d months ahead)", conflictHorizonMonths)
+		}
+
+		warnings = append(warnings, &models.Warning{
+			Code:    models.WarningConflict,
+			Message: message,
+			Event:   e,
+		})
+	}
+
+	return append(warnings, availability[userID].warnings(occurrences)...), nil
+}
+
+// conflicts returns the user's events within [from, to) that overlap any of
+// the occurrences. Other occurrences of the candidate itself never conflict.
+func (s *Service) conflicts(ctx context.Context, userID int, candidateID uint, occurrences []*models.Event,
+	from, to time.Time) ([]*models.Event, error) {
+	existing, err := s.busyEvents(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []*models.Event
+	for _, e := range existing {
+		if candidateID != 0 && e.ID == candidateID {
+			continue
+		}
+
+		for _, o := range occurrences {
+			if o.Date.Before(e.EndDate) && e.Date.Before(o.EndDate) {
+				conflicts = append(conflicts, e)
+				break
+			}
+		}
+	}
+
+	return conflicts, nil
+}
+
+// busyEvents returns the occurrences within [from, to) of the events that take
+// up the user's time, in order of their start.
+func (s *Service) busyEvents(ctx context.Context, userID int, from, to time.Time) ([]*models.Event, error) {
+	events, err := s.events(ctx, &models.EventGet{UserID: userID, DateFrom: from, DateTo: to})
+	if err != nil {
+		return nil, err
+	}
+
+	busy := events[:0]
+	for _, e := range events {
+		if !isFree(e) && attends(e, userID) {
+			busy = append(busy, e)
+		}
+	}
+
+	return busy, nil
+}
+
+// isFree reports whether the event leaves the time free: instant and all-day
+// events do.
+func isFree(e *models.Event) bool {
+	return e.AllDay || !e.EndDate.After(e.Date)
+}
+
+// attends reports whether the user takes part in the event: they created it
+// or accepted, or have not yet declined, an invitation to it.
+func attends(e *models.Event, userID int) bool {
+	if e.RSVPStatus != "" {
+		return e.RSVPStatus != models.AttendeeDeclined
+	}
+
+	return e.UserID == userID
+}
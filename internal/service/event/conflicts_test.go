@@ -0,0 +1,162 @@
+//go:build unit
+// +build unit
+
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	eventR "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	eventRepository "github.com/avraam311/calendar-service/internal/repository/event"
+)
+
+func TestServiceCreateEventWarnsAboutConflicts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	ev := &models.EventCreate{UserID: 1, Title: "Review", Date: start, EndDate: start.Add(time.Hour)}
+	existing := []*models.Event{
+		{ID: 3, UserID: 1, Title: "Standup", Date: start.Add(-30 * time.Minute), EndDate: start.Add(30 * time.Minute),
+			TimeZone: "UTC"},
+		{ID: 4, UserID: 1, Title: "Lunch", Date: start.Add(time.Hour), EndDate: start.Add(2 * time.Hour), TimeZone: "UTC"},
+		{ID: 5, UserID: 2, Title: "Declined", Date: start, EndDate: start.Add(time.Hour), TimeZone: "UTC",
+			RSVPStatus: models.AttendeeDeclined},
+	}
+
+	mockRepo.EXPECT().WritableCalendar(gomock.Any(), 1, uint(0)).Return(uint(4), nil)
+	mockRepo.EXPECT().
+		GetEvents(gomock.Any(), &models.EventGet{UserID: 1, DateFrom: start, DateTo: start.Add(time.Hour)}).
+		Return(existing, nil)
+	mockRepo.EXPECT().GetAvailability(gomock.Any(), []int{1}, start, start.Add(time.Hour)).Return(nil, nil)
+	mockRepo.EXPECT().CreateEvent(gomock.Any(), ev).Return(uint(9), nil)
+
+	ID, warnings, err := svc.CreateEvent(context.Background(), ev)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ID != 9 {
+		t.Fatalf("expected id %v, got %v", 9, ID)
+	}
+	if len(warnings) != 1 || warnings[0].Code != models.WarningConflict || warnings[0].Event.ID != 3 {
+		t.Fatalf("expected conflict with event 3, got %v", warnings)
+	}
+}
+
+func TestServiceCreateRecurringEventChecksWithinHorizon(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	ev := &models.EventCreate{UserID: 1, Title: "Review", Date: start, EndDate: start.Add(time.Hour),
+		RRule: "FREQ=MONTHLY"}
+	horizon := start.AddDate(0, conflictHorizonMonths, 0)
+
+	mockRepo.EXPECT().WritableCalendar(gomock.Any(), 1, uint(0)).Return(uint(4), nil)
+	mockRepo.EXPECT().
+		GetEvents(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, eventGet *models.EventGet) ([]*models.Event, error) {
+			if !eventGet.DateTo.Before(horizon) {
+				t.Fatalf("expected occurrences before %v, got window to %v", horizon, eventGet.DateTo)
+			}
+			return []*models.Event{
+				{ID: 3, UserID: 1, Date: start.AddDate(0, 1, 0), EndDate: start.AddDate(0, 1, 0).Add(time.Hour),
+					TimeZone: "UTC"},
+			}, nil
+		})
+	mockRepo.EXPECT().GetAvailability(gomock.Any(), []int{1}, gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockRepo.EXPECT().CreateEvent(gomock.Any(), ev).Return(uint(9), nil)
+
+	_, warnings, err := svc.CreateEvent(context.Background(), ev)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 1 || warnings[0].Message != "overlaps event 3 (occurrences are checked 12 months ahead)" {
+		t.Fatalf("unexpected warnings %v", warnings)
+	}
+}
+
+func TestServiceCreateEventRejectsConflicts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	ev := &models.EventCreate{UserID: 1, Title: "Review", Date: start, EndDate: start.Add(time.Hour),
+		OnConflict: models.ConflictReject}
+
+	mockRepo.EXPECT().WritableCalendar(gomock.Any(), 1, uint(0)).Return(uint(4), nil)
+	mockRepo.EXPECT().GetEvents(gomock.Any(), gomock.Any()).Return([]*models.Event{
+		{ID: 3, UserID: 1, Date: start, EndDate: start.Add(time.Hour), TimeZone: "UTC"},
+	}, nil)
+
+	_, _, err := svc.CreateEvent(context.Background(), ev)
+
+	var conflictErr *ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("expected ConflictError, got %v", err)
+	}
+	if len(conflictErr.Events) != 1 || conflictErr.Events[0].ID != 3 {
+		t.Fatalf("unexpected conflicts %v", conflictErr.Events)
+	}
+}
+
+func TestServiceUpdateEventIgnoresItself(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	ev := &models.EventUpdate{
+		Event:      models.Event{ID: 3, UserID: 1, Title: "Review", Date: start, EndDate: start.Add(time.Hour)},
+		OnConflict: models.ConflictReject,
+	}
+
+	mockRepo.EXPECT().GetEvent(gomock.Any(), 1, uint(3)).Return(&models.Event{ID: 3, UserID: 1}, nil)
+	mockRepo.EXPECT().GetEvents(gomock.Any(), gomock.Any()).Return([]*models.Event{
+		{ID: 3, UserID: 1, Date: start.Add(-time.Hour), EndDate: start.Add(time.Hour), TimeZone: "UTC"},
+	}, nil)
+	mockRepo.EXPECT().GetAvailability(gomock.Any(), []int{1}, gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockRepo.EXPECT().UpdateEvent(gomock.Any(), &ev.Event).Return(uint(3), nil)
+
+	if _, _, err := svc.UpdateEvent(context.Background(), ev); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestServiceUpdateEventNotFoundBeforeConflicts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	ev := &models.EventUpdate{
+		Event:      models.Event{ID: 3, UserID: 1, Title: "Review", Date: start, EndDate: start.Add(time.Hour)},
+		OnConflict: models.ConflictReject,
+	}
+
+	mockRepo.EXPECT().GetEvent(gomock.Any(), 1, uint(3)).Return(nil, eventRepository.ErrEventNotFound)
+
+	_, _, err := svc.UpdateEvent(context.Background(), ev)
+	if !errors.Is(err, eventRepository.ErrEventNotFound) {
+		t.Fatalf("expected ErrEventNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package event
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// encodeCursor returns the opaque position right after the occurrence. The
+// format is an implementation detail clients must not rely on.
+func encodeCursor(e *models.Event) string {
+	raw := fmt.Sprintf("%d:%d", e.Date.UnixNano(), e.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (*models.EventCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	date, ID, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	nanos, err := strconv.ParseInt(date, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	eventID, err := strconv.ParseUint(ID, 10, 0)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &models.EventCursor{Date: time.Unix(0, nanos).UTC(), ID: uint(eventID)}, nil
+}
+
+// follows reports whether the occurrence comes after the cursor in the order
+// of date and ID, or before it if desc is set.
+func follows(e *models.Event, c *models.EventCursor, desc bool) bool {
+	switch {
+	case !e.Date.Equal(c.Date):
+		return e.Date.After(c.Date) != desc
+	case e.ID != c.ID:
+		return (e.ID > c.ID) != desc
+	default:
+		return false
+	}
+}
@@ -0,0 +1,180 @@
+//go:build unit
+// +build unit
+
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	eventR "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	e := &models.Event{ID: 42, Date: time.Date(2026, 3, 1, 9, 30, 0, 0, time.FixedZone("MSK", 3*3600))}
+
+	c, err := decodeCursor(encodeCursor(e))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !c.Date.Equal(e.Date) || c.ID != 42 {
+		t.Fatalf("unexpected cursor %+v", c)
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	for _, cursor := range []string{"%%%", "bm90LWEtY3Vyc29y", "eDox"} {
+		if _, err := decodeCursor(cursor); !errors.Is(err, ErrInvalidCursor) {
+			t.Fatalf("expected ErrInvalidCursor for %q, got %v", cursor, err)
+		}
+	}
+}
+
+func TestServiceGetEventsPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	series := func() *models.Event {
+		return &models.Event{ID: 3, UserID: 1, Date: from.Add(8 * time.Hour), EndDate: from.Add(8*time.Hour + 15*time.Minute),
+			TimeZone: "UTC", RRule: "FREQ=DAILY"}
+	}
+	single := func(ID uint, date time.Time) *models.Event {
+		return &models.Event{ID: ID, UserID: 1, Date: date, EndDate: date.Add(time.Hour), TimeZone: "UTC"}
+	}
+	day2 := from.AddDate(0, 0, 1)
+
+	mockRepo.EXPECT().GetEvents(gomock.Any(), gomock.Any()).
+		Return([]*models.Event{single(5, from.Add(9*time.Hour)), single(6, day2.Add(10*time.Hour)), series()}, nil)
+	mockRepo.EXPECT().GetAvailability(gomock.Any(), []int{1}, gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+
+	getData := &models.EventGet{UserID: 1, DateFrom: from, DateTo: from.AddDate(0, 0, 3), Limit: 2}
+	page, err := svc.GetEventsPage(context.Background(), getData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Events) != 2 || page.Events[0].ID != 3 || page.Events[1].ID != 5 {
+		t.Fatalf("unexpected first page: %+v", page.Events)
+	}
+	if page.NextCursor == "" {
+		t.Fatal("expected a next cursor")
+	}
+
+	mockRepo.EXPECT().GetEvents(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, eventGet *models.EventGet) ([]*models.Event, error) {
+			if eventGet.After == nil || eventGet.After.ID != 5 || !eventGet.After.Date.Equal(from.Add(9*time.Hour)) {
+				t.Fatalf("unexpected position %+v", eventGet.After)
+			}
+			return []*models.Event{single(6, day2.Add(10*time.Hour)), series()}, nil
+		})
+
+	getData = &models.EventGet{UserID: 1, DateFrom: from, DateTo: from.AddDate(0, 0, 3), Limit: 2, Cursor: page.NextCursor}
+	page, err = svc.GetEventsPage(context.Background(), getData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Events) != 2 || !page.Events[0].Date.Equal(day2.Add(8*time.Hour)) || page.Events[1].ID != 6 {
+		t.Fatalf("unexpected second page: %+v", page.Events)
+	}
+	if page.NextCursor == "" {
+		t.Fatal("expected a next cursor")
+	}
+}
+
+func TestServiceGetEventsPageLast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	mockRepo.EXPECT().GetEvents(gomock.Any(), gomock.Any()).Return([]*models.Event{
+		{ID: 1, UserID: 1, Date: from.Add(9 * time.Hour), EndDate: from.Add(10 * time.Hour), TimeZone: "UTC"},
+	}, nil)
+	mockRepo.EXPECT().GetAvailability(gomock.Any(), []int{1}, gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	page, err := svc.GetEventsPage(context.Background(),
+		&models.EventGet{UserID: 1, DateFrom: from, DateTo: from.AddDate(0, 0, 1), Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Events) != 1 || page.NextCursor != "" {
+		t.Fatalf("expected the last page, got %+v", page)
+	}
+}
+
+func TestServiceGetEventsPageInvalidCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(eventR.NewMockeventRepo(ctrl))
+
+	_, err := svc.GetEventsPage(context.Background(), &models.EventGet{UserID: 1, Cursor: "garbage"})
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected ErrInvalidCursor, got %v", err)
+	}
+}
+
+func TestServiceGetEventsPageDesc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	mockRepo.EXPECT().GetEvents(gomock.Any(), gomock.Any()).Return([]*models.Event{
+		{ID: 7, UserID: 1, Date: from.Add(9 * time.Hour), EndDate: from.Add(10 * time.Hour), TimeZone: "UTC"},
+		{ID: 3, UserID: 1, Date: from.Add(8 * time.Hour), EndDate: from.Add(9 * time.Hour), TimeZone: "UTC",
+			RRule: "FREQ=DAILY;COUNT=2"},
+	}, nil)
+	mockRepo.EXPECT().GetAvailability(gomock.Any(), []int{1}, gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	cursor := encodeCursor(&models.Event{ID: 3, Date: from.AddDate(0, 0, 1).Add(8 * time.Hour)})
+	page, err := svc.GetEventsPage(context.Background(), &models.EventGet{UserID: 1, DateFrom: from,
+		DateTo: from.AddDate(0, 0, 2), Sort: models.SortDateDesc, Limit: 1, Cursor: cursor})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Events) != 1 || page.Events[0].ID != 7 {
+		t.Fatalf("unexpected page: %+v", page.Events)
+	}
+	if page.NextCursor == "" {
+		t.Fatal("expected a next cursor")
+	}
+}
+
+func TestServiceGetEventsPageTextQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	mockRepo.EXPECT().GetEvents(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, eventGet *models.EventGet) ([]*models.Event, error) {
+			if eventGet.TSQuery != "'budget':*" {
+				t.Fatalf("unexpected tsquery %q", eventGet.TSQuery)
+			}
+			return []*models.Event{}, nil
+		})
+
+	_, err := svc.GetEventsPage(context.Background(), &models.EventGet{UserID: 1, Query: "budget*"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = svc.GetEventsPage(context.Background(), &models.EventGet{UserID: 1, Query: "!!"})
+	if !errors.Is(err, ErrEmptySearchQuery) {
+		t.Fatalf("expected ErrEmptySearchQuery, got %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+var ErrNotCalendarPeer = errors.New("user does not share a calendar with the caller")
+
+// FreeBusy returns, for each user, the merged intervals within the query
+// window in which the user is busy. Nothing about the events themselves is
+// returned. The caller may ask only about themselves and users who share a
+// calendar with them.
+func (s *Service) FreeBusy(ctx context.Context, query *models.FreeBusyQuery) ([]*models.FreeBusy, error) {
+	if err := s.checkPeers(ctx, query.UserID, query.UserIDs); err != nil {
+		return nil, fmt.Errorf("service/FreeBusy - %w", err)
+	}
+
+	result, err := s.freeBusy(ctx, query.UserIDs, query.DateFrom, query.DateTo)
+	if err != nil {
+		return nil, fmt.Errorf("service/FreeBusy - %w", err)
+	}
+
+	return result, nil
+}
+
+func (s *Service) freeBusy(ctx context.Context, userIDs []int, from, to time.Time) ([]*models.FreeBusy, error) {
+	result := make([]*models.FreeBusy, 0, len(userIDs))
+	for _, userID := range userIDs {
+		events, err := s.busyEvents(ctx, userID, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.FreeBusy{
+			UserID: userID,
+			Busy:   mergeBusy(events, from, to),
+		})
+	}
+
+	return result, nil
+}
+
+// checkPeers fails with ErrNotCalendarPeer unless each of userIDs is the
+// caller or a member of a calendar the caller is a member of.
+func (s *Service) checkPeers(ctx context.Context, userID int, userIDs []int) error {
+	others := make(map[int]struct{}, len(userIDs))
+	for _, ID := range userIDs {
+		if ID != userID {
+			others[ID] = struct{}{}
+		}
+	}
+	if len(others) == 0 {
+		return nil
+	}
+
+	peers, err := s.eventRepo.CalendarPeers(ctx, userID, userIDs)
+	if err != nil {
+		return err
+	}
+	for _, ID := range peers {
+		delete(others, ID)
+	}
+
+	if len(others) > 0 {
+		return ErrNotCalendarPeer
+	}
+
+	return nil
+}
+
+// mergeBusy clips the events, sorted by start, to [from, to) and merges those
+// that overlap or touch into single intervals.
+func mergeBusy(events []*models.Event, from, to time.Time) []models.BusyInterval {
+	busy := []models.BusyInterval{}
+	for _, e := range events {
+		start, end := e.Date.UTC(), e.EndDate.UTC()
+		if start.Before(from) {
+			start = from.UTC()
+		}
+		if end.After(to) {
+			end = to.UTC()
+		}
+
+		if n := len(busy); n > 0 && !start.After(busy[n-1].End) {
+			if end.After(busy[n-1].End) {
+				busy[n-1].End = end
+			}
+			continue
+		}
+
+		busy = append(busy, models.BusyInterval{Start: start, End: end})
+	}
+
+	return busy
+}
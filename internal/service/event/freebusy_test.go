@@ -0,0 +1,75 @@
+//go:build unit
+// +build unit
+
+package event
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	eventR "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func TestServiceFreeBusyMergesIntervals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+	at := func(h, m int) time.Time { return from.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute) }
+
+	mockRepo.EXPECT().CalendarPeers(gomock.Any(), 1, []int{2}).Return([]int{2}, nil)
+	mockRepo.EXPECT().
+		GetEvents(gomock.Any(), &models.EventGet{UserID: 2, DateFrom: from, DateTo: to}).
+		Return([]*models.Event{
+			{ID: 1, UserID: 2, Date: at(-1, 0), EndDate: at(1, 0), TimeZone: "UTC"},
+			{ID: 2, UserID: 2, Date: at(9, 0), EndDate: at(10, 0), TimeZone: "UTC"},
+			{ID: 3, UserID: 2, Date: at(9, 30), EndDate: at(11, 0), TimeZone: "UTC"},
+			{ID: 4, UserID: 2, Date: at(11, 0), EndDate: at(11, 30), TimeZone: "UTC"},
+			{ID: 5, UserID: 2, Date: at(13, 0), EndDate: at(13, 0), TimeZone: "UTC"},
+			{ID: 6, UserID: 3, Date: at(14, 0), EndDate: at(15, 0), TimeZone: "UTC", RSVPStatus: models.AttendeeDeclined},
+			{ID: 7, UserID: 2, Date: from, EndDate: to, AllDay: true, TimeZone: "UTC"},
+			{ID: 8, UserID: 3, Date: at(16, 0), EndDate: at(17, 0), TimeZone: "UTC", RSVPStatus: models.AttendeeAccepted},
+		}, nil)
+
+	result, err := svc.FreeBusy(context.Background(),
+		&models.FreeBusyQuery{UserID: 1, UserIDs: []int{2}, DateFrom: from, DateTo: to})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []models.BusyInterval{
+		{Start: from, End: at(1, 0)},
+		{Start: at(9, 0), End: at(11, 30)},
+		{Start: at(16, 0), End: at(17, 0)},
+	}
+	if len(result) != 1 || result[0].UserID != 2 || !reflect.DeepEqual(result[0].Busy, want) {
+		t.Fatalf("unexpected free/busy %+v", result[0])
+	}
+}
+
+func TestServiceFreeBusyOutsideSharedCalendars(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	mockRepo.EXPECT().CalendarPeers(gomock.Any(), 1, []int{1, 2, 3}).Return([]int{2}, nil)
+
+	_, err := svc.FreeBusy(context.Background(),
+		&models.FreeBusyQuery{UserID: 1, UserIDs: []int{1, 2, 3}, DateFrom: from, DateTo: from.AddDate(0, 0, 1)})
+	if !errors.Is(err, ErrNotCalendarPeer) {
+		t.Fatalf("expected ErrNotCalendarPeer, got %v", err)
+	}
+}
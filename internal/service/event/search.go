@@ -0,0 +1,116 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+const defaultSearchLimit = 20
+
+var ErrEmptySearchQuery = errors.New("search query has no words")
+
+// SearchEvents finds the user's events by their text. Recurring events are
+// returned once, as their series, and only if an occurrence falls within the
+// window when both of its ends are given.
+func (s *Service) SearchEvents(ctx context.Context, search *models.EventSearch) ([]*models.Event, error) {
+	query := tsQuery(search.Query)
+	if query == "" {
+		return nil, fmt.Errorf("service/SearchEvents - %w", ErrEmptySearchQuery)
+	}
+
+	if search.Limit == 0 {
+		search.Limit = defaultSearchLimit
+	}
+
+	events, err := s.eventRepo.SearchEvents(ctx, search, query)
+	if err != nil {
+		return nil, fmt.Errorf("service/SearchEvents - %w", err)
+	}
+
+	result := events[:0]
+	for _, e := range events {
+		localize(e)
+		if e.RRule != "" && !search.DateFrom.IsZero() && !search.DateTo.IsZero() {
+			c := *e
+			occurrences, err := Expand(&c, search.DateFrom, search.DateTo)
+			if err != nil {
+				return nil, fmt.Errorf("service/SearchEvents - %w", err)
+			}
+			if len(occurrences) == 0 {
+				continue
+			}
+		}
+
+		result = append(result, e)
+	}
+
+	return result, nil
+}
+
+// tsQuery turns a search string into a to_tsquery expression. All words must
+// match, words in double quotes must match as a phrase and a word followed by
+// "*" matches as a prefix. Anything but letters and digits separates words,
+// so the result needs no escaping. An empty result means there are no words.
+func tsQuery(q string) string {
+	var (
+		terms  []string
+		phrase []string
+		quoted bool
+	)
+
+	addPhrase := func() {
+		switch len(phrase) {
+		case 0:
+		case 1:
+			terms = append(terms, phrase[0])
+		default:
+			terms = append(terms, "("+strings.Join(phrase, " <-> ")+")")
+		}
+		phrase = nil
+	}
+
+	runes := []rune(q)
+	for i := 0; i < len(runes); {
+		switch r := runes[i]; {
+		case r == '"':
+			if quoted {
+				addPhrase()
+			}
+			quoted = !quoted
+			i++
+		case isWordRune(r):
+			j := i
+			for j < len(runes) && isWordRune(runes[j]) {
+				j++
+			}
+
+			word := "'" + string(runes[i:j]) + "'"
+			if j < len(runes) && runes[j] == '*' {
+				word += ":*"
+				j++
+			}
+
+			if quoted {
+				phrase = append(phrase, word)
+			} else {
+				terms = append(terms, word)
+			}
+			i = j
+		default:
+			i++
+		}
+	}
+	// An unterminated quote still makes a phrase.
+	addPhrase()
+
+	return strings.Join(terms, " & ")
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
@@ -0,0 +1,79 @@
+//go:build unit
+// +build unit
+
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	eventR "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func TestTSQuery(t *testing.T) {
+	tests := []struct {
+		q    string
+		want string
+	}{
+		{"team sync", "'team' & 'sync'"},
+		{`"weekly review" notes`, "('weekly' <-> 'review') & 'notes'"},
+		{"stand*", "'stand':*"},
+		{`"quarterly plan*`, "('quarterly' <-> 'plan':*)"},
+		{"Встреча, 1:1!", "'Встреча' & '1' & '1'"},
+		{`it's`, "'it' & 's'"},
+		{`"" * -`, ""},
+	}
+
+	for _, tt := range tests {
+		if got := tsQuery(tt.q); got != tt.want {
+			t.Errorf("tsQuery(%q) = %q, want %q", tt.q, got, tt.want)
+		}
+	}
+}
+
+func TestServiceSearchEventsEmptyQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := New(eventR.NewMockeventRepo(ctrl))
+
+	_, err := svc.SearchEvents(context.Background(), &models.EventSearch{UserID: 1, Query: "***"})
+	if !errors.Is(err, ErrEmptySearchQuery) {
+		t.Fatalf("expected ErrEmptySearchQuery, got %v", err)
+	}
+}
+
+func TestServiceSearchEventsSkipsSeriesOutsideWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	search := &models.EventSearch{UserID: 1, Query: "standup", DateFrom: from, DateTo: from.AddDate(0, 0, 7)}
+	start := from.AddDate(0, -1, 0).Add(9 * time.Hour)
+
+	mockRepo.EXPECT().
+		SearchEvents(gomock.Any(), &models.EventSearch{UserID: 1, Query: "standup", DateFrom: from,
+			DateTo: from.AddDate(0, 0, 7), Limit: defaultSearchLimit}, "'standup'").
+		Return([]*models.Event{
+			{ID: 1, UserID: 1, Title: "Standup", Date: start, EndDate: start.Add(15 * time.Minute), TimeZone: "UTC",
+				RRule: "FREQ=DAILY;COUNT=5"},
+			{ID: 2, UserID: 1, Title: "Standup notes", Date: start, EndDate: start.Add(15 * time.Minute),
+				TimeZone: "UTC", RRule: "FREQ=WEEKLY"},
+		}, nil)
+
+	events, err := svc.SearchEvents(context.Background(), search)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 1 || events[0].ID != 2 {
+		t.Fatalf("expected only the series still running, got %v", events)
+	}
+}
@@ -2,17 +2,44 @@ package event
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"time"
 
 	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/rrule"
+)
+
+var (
+	ErrRecurrenceIDRequired = errors.New("recurrence_id is required for this scope")
 )
 
 //go:generate mockgen -source=service.go -destination=../../mocks/mock_service.go -package=mocks
 type eventRepo interface {
 	CreateEvent(ctx context.Context, event *models.EventCreate) (uint, error)
 	UpdateEvent(ctx context.Context, event *models.Event) (uint, error)
-	DeleteEvent(ctx context.Context, ID uint) (uint, error)
+	DeleteEvent(ctx context.Context, userID int, ID uint) (uint, error)
+	GetEvent(ctx context.Context, userID int, ID uint) (*models.Event, error)
+	GetEventByUID(ctx context.Context, userID int, UID string) (*models.Event, error)
 	GetEvents(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error)
+	GetArchivedEvents(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error)
+	WritableCalendar(ctx context.Context, userID int, calendarID uint) (uint, error)
+	AddAttendees(ctx context.Context, eventID uint, userIDs []int) error
+	CopyAttendees(ctx context.Context, fromID, toID uint) error
+	GetAttendees(ctx context.Context, eventID uint) ([]*models.Attendee, error)
+	SetAttendeeStatus(ctx context.Context, rsvp *models.EventRSVP) error
+	DeleteAttendee(ctx context.Context, eventID uint, userID int) error
+	GetAvailability(ctx context.Context, userIDs []int, from, to time.Time) ([]*models.Availability, error)
+	CalendarPeers(ctx context.Context, userID int, userIDs []int) ([]int, error)
+	SearchEvents(ctx context.Context, search *models.EventSearch, tsQuery string) ([]*models.Event, error)
+	CreateTag(ctx context.Context, tag *models.Tag) (uint, error)
+	GetTags(ctx context.Context, userID int) ([]*models.Tag, error)
+	UpdateTag(ctx context.Context, tag *models.Tag) error
+	DeleteTag(ctx context.Context, userID int, ID uint) error
+	SetEventTags(ctx context.Context, eventTags *models.EventTags) error
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type Service struct {
@@ -25,26 +52,376 @@ func New(r eventRepo) *Service {
 	}
 }
 
-func (s *Service) CreateEvent(ctx context.Context, event *models.EventCreate) (uint, error) {
+// InTx runs fn in one transaction: the changes the service makes with the
+// context passed to fn are committed together or not at all.
+func (s *Service) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := s.eventRepo.InTx(ctx, fn); err != nil {
+		return fmt.Errorf("service/InTx - %w", err)
+	}
+
+	return nil
+}
+
+// CreateEvent stores the event and returns its ID along with warnings about
+// the user's events it overlaps and the user's availability. Overlapping
+// events fail the call instead if event.OnConflict asks to reject them.
+func (s *Service) CreateEvent(ctx context.Context, event *models.EventCreate) (uint, []*models.Warning, error) {
+	if event.TimeZone == "" {
+		event.TimeZone = time.UTC.String()
+	}
+	event.Date, event.EndDate = normalizeTimes(event.Date, event.EndDate, event.AllDay, location(event.TimeZone))
+
+	calendarID, err := s.eventRepo.WritableCalendar(ctx, event.UserID, event.CalendarID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("service/CreateEvent - %w", err)
+	}
+	event.CalendarID = calendarID
+
+	warnings, err := s.checkConflicts(ctx, event.UserID, &models.Event{
+		Date:     event.Date,
+		EndDate:  event.EndDate,
+		AllDay:   event.AllDay,
+		TimeZone: event.TimeZone,
+		RRule:    event.RRule,
+		ExDates:  event.ExDates,
+	}, event.OnConflict)
+	if err != nil {
+		return 0, nil, fmt.Errorf("service/CreateEvent - %w", err)
+	}
+
 	ID, err := s.eventRepo.CreateEvent(ctx, event)
 	if err != nil {
-		return 0, fmt.Errorf("service/CreateEvent - %w", err)
+		return 0, nil, fmt.Errorf("service/CreateEvent - %w", err)
+	}
+
+	return ID, warnings, nil
+}
+
+// ImportEvents stores imported events, deduplicating them by UID: a known UID
+// updates the stored event unless nothing changed, in which case it is skipped.
+// New events go to the user's default calendar. The import is all or nothing:
+// the events are stored in one transaction.
+func (s *Service) ImportEvents(ctx context.Context, events []*models.EventCreate) ([]*models.ImportResult, error) {
+	type calendarKey struct {
+		userID     int
+		calendarID uint
+	}
+	calendars := make(map[calendarKey]uint)
+
+	results := make([]*models.ImportResult, 0, len(events))
+	err := s.eventRepo.InTx(ctx, func(ctx context.Context) error {
+		for _, event := range events {
+			key := calendarKey{event.UserID, event.CalendarID}
+			calendarID, ok := calendars[key]
+			if !ok {
+				var err error
+				calendarID, err = s.eventRepo.WritableCalendar(ctx, event.UserID, event.CalendarID)
+				if err != nil {
+					return err
+				}
+				calendars[key] = calendarID
+			}
+			event.CalendarID = calendarID
+
+			if event.TimeZone == "" {
+				event.TimeZone = time.UTC.String()
+			}
+			loc := location(event.TimeZone)
+			event.Date, event.EndDate = normalizeTimes(event.Date, event.EndDate, event.AllDay, loc)
+
+			result, err := s.importEvent(ctx, event)
+			if err != nil {
+				return err
+			}
+
+			results = append(results, result)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("service/ImportEvents - %w", err)
+	}
+
+	return results, nil
+}
+
+func (s *Service) importEvent(ctx context.Context, event *models.EventCreate) (*models.ImportResult, error) {
+	existing, err := s.eventRepo.GetEventByUID(ctx, event.UserID, event.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		ID, err := s.eventRepo.CreateEvent(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+
+		return &models.ImportResult{UID: event.UID, ID: ID, Status: models.ImportCreated}, nil
+	}
+
+	// iCalendar has no place for metadata, so the stored one is kept.
+	updated := &models.Event{
+		ID:          existing.ID,
+		UserID:      event.UserID,
+		Title:       event.Title,
+		Description: event.Description,
+		Location:    event.Location,
+		URL:         event.URL,
+		Metadata:    existing.Metadata,
+		Date:        event.Date,
+		EndDate:     event.EndDate,
+		AllDay:      event.AllDay,
+		TimeZone:    event.TimeZone,
+		Reminders:   event.Reminders,
+		RRule:       event.RRule,
+		ExDates:     event.ExDates,
+	}
+	if sameEvent(existing, updated) {
+		return &models.ImportResult{UID: event.UID, ID: existing.ID, Status: models.ImportSkipped}, nil
+	}
+
+	if _, err = s.eventRepo.UpdateEvent(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	return &models.ImportResult{UID: event.UID, ID: existing.ID, Status: models.ImportUpdated}, nil
+}
+
+// UpdateEvent changes the event and, like CreateEvent, warns about overlapping
+// events and the user's availability.
+func (s *Service) UpdateEvent(ctx context.Context, event *models.EventUpdate) (uint, []*models.Warning, error) {
+	if event.TimeZone == "" {
+		event.TimeZone = time.UTC.String()
+	}
+	event.Date, event.EndDate = normalizeTimes(event.Date, event.EndDate, event.AllDay, location(event.TimeZone))
+
+	if event.RecurrenceID == nil && (event.Scope == models.ScopeThis || event.Scope == models.ScopeFollowing) {
+		return 0, nil, fmt.Errorf("service/UpdateEvent - %w", ErrRecurrenceIDRequired)
+	}
+
+	// A missing or read-only event is reported as such before any conflicts.
+	master, err := s.eventRepo.GetEvent(ctx, event.UserID, event.ID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("service/UpdateEvent - %w", err)
+	}
+
+	candidate := event.Event
+	if event.Scope == models.ScopeThis {
+		candidate.RRule = ""
+	}
+	warnings, err := s.checkConflicts(ctx, event.UserID, &candidate, event.OnConflict)
+	if err != nil {
+		return 0, nil, fmt.Errorf("service/UpdateEvent - %w", err)
+	}
+
+	if event.RecurrenceID == nil {
+		ID, err := s.eventRepo.UpdateEvent(ctx, &event.Event)
+		if err != nil {
+			return 0, nil, fmt.Errorf("service/UpdateEvent - %w", err)
+		}
+
+		return ID, warnings, nil
+	}
+
+	localize(master)
+	master.UserID = event.UserID
+
+	var ID uint
+	switch {
+	case master.RRule == "":
+		event.RRule = ""
+		ID, err = s.eventRepo.UpdateEvent(ctx, &event.Event)
+	case event.Scope == models.ScopeThis:
+		ID, err = s.updateOccurrence(ctx, master, event)
+	case event.Scope == models.ScopeFollowing && event.RecurrenceID.After(master.Date):
+		ID, err = s.updateFollowing(ctx, master, event)
+	default:
+		ID, err = s.updateSeries(ctx, master, event)
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("service/UpdateEvent - %w", err)
+	}
+
+	return ID, warnings, nil
+}
+
+// updateSeries applies an edit made on one occurrence to the whole series,
+// moving the series by the same offset the occurrence was moved.
+func (s *Service) updateSeries(ctx context.Context, master *models.Event, event *models.EventUpdate) (uint, error) {
+	shift := event.Date.Sub(*event.RecurrenceID)
+
+	master.UserID = event.UserID
+	master.Title = event.Title
+	master.Description = event.Description
+	master.Location = event.Location
+	master.URL = event.URL
+	master.Metadata = event.Metadata
+	master.EndDate = master.Date.Add(shift).Add(event.EndDate.Sub(event.Date))
+	master.Date = master.Date.Add(shift)
+	master.AllDay = event.AllDay
+	master.TimeZone = event.TimeZone
+	master.Reminders = event.Reminders
+	if event.RRule != "" {
+		master.RRule = event.RRule
+	}
+	for i := range master.ExDates {
+		master.ExDates[i] = master.ExDates[i].Add(shift)
+	}
+
+	return s.eventRepo.UpdateEvent(ctx, master)
+}
+
+// updateOccurrence detaches a single occurrence from its series: the series
+// gets an EXDATE and the edited occurrence becomes a standalone event, both in
+// one transaction.
+func (s *Service) updateOccurrence(ctx context.Context, master *models.Event, event *models.EventUpdate) (uint, error) {
+	master.ExDates = append(master.ExDates, *event.RecurrenceID)
+
+	var ID uint
+	err := s.eventRepo.InTx(ctx, func(ctx context.Context) error {
+		if _, err := s.eventRepo.UpdateEvent(ctx, master); err != nil {
+			return err
+		}
+
+		var err error
+		ID, err = s.splitOff(ctx, master.ID, &models.EventCreate{
+			UserID:      event.UserID,
+			CalendarID:  master.CalendarID,
+			Title:       event.Title,
+			Description: event.Description,
+			Location:    event.Location,
+			URL:         event.URL,
+			Metadata:    event.Metadata,
+			Date:        event.Date,
+			EndDate:     event.EndDate,
+			AllDay:      event.AllDay,
+			TimeZone:    event.TimeZone,
+			Reminders:   event.Reminders,
+		})
+		return err
+	})
+	if err != nil {
+		return 0, err
 	}
 
 	return ID, nil
 }
 
-func (s *Service) UpdateEvent(ctx context.Context, event *models.Event) (uint, error) {
-	ID, err := s.eventRepo.UpdateEvent(ctx, event)
+// updateFollowing splits the series at the edited occurrence in one
+// transaction: the original series ends right before it and a new series
+// starts with the changes.
+func (s *Service) updateFollowing(ctx context.Context, master *models.Event, event *models.EventUpdate) (uint, error) {
+	rule, err := rrule.Parse(master.RRule)
 	if err != nil {
-		return 0, fmt.Errorf("service/UpdateEvent - %w", err)
+		return 0, err
+	}
+
+	newRule := event.RRule
+	if newRule == "" || newRule == master.RRule {
+		next := *rule
+		if next.Count > 0 {
+			next.Count -= len(rule.Between(master.Date, master.Date, event.RecurrenceID.Add(-time.Nanosecond)))
+		}
+		newRule = next.String()
+	}
+
+	exDates := futureExDates(master.ExDates, *event.RecurrenceID)
+
+	var ID uint
+	err = s.eventRepo.InTx(ctx, func(ctx context.Context) error {
+		if err := s.truncateSeries(ctx, master, rule, *event.RecurrenceID); err != nil {
+			return err
+		}
+
+		var err error
+		ID, err = s.splitOff(ctx, master.ID, &models.EventCreate{
+			UserID:      event.UserID,
+			CalendarID:  master.CalendarID,
+			Title:       event.Title,
+			Description: event.Description,
+			Location:    event.Location,
+			URL:         event.URL,
+			Metadata:    event.Metadata,
+			Date:        event.Date,
+			EndDate:     event.EndDate,
+			AllDay:      event.AllDay,
+			TimeZone:    event.TimeZone,
+			Reminders:   event.Reminders,
+			RRule:       newRule,
+			ExDates:     exDates,
+		})
+		return err
+	})
+	if err != nil {
+		return 0, err
 	}
 
 	return ID, nil
 }
 
-func (s *Service) DeleteEvent(ctx context.Context, ID uint) (uint, error) {
-	ID, err := s.eventRepo.DeleteEvent(ctx, ID)
+// splitOff creates the event split off the series and invites the series'
+// attendees to it. Callers run it in the transaction that changes the series.
+func (s *Service) splitOff(ctx context.Context, masterID uint, event *models.EventCreate) (uint, error) {
+	ID, err := s.eventRepo.CreateEvent(ctx, event)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = s.eventRepo.CopyAttendees(ctx, masterID, ID); err != nil {
+		return 0, err
+	}
+
+	return ID, nil
+}
+
+func (s *Service) truncateSeries(ctx context.Context, master *models.Event, rule *rrule.Rule, at time.Time) error {
+	rule.Count = 0
+	rule.Until = at.Add(-time.Second)
+	master.RRule = rule.String()
+	master.ExDates = pastExDates(master.ExDates, at)
+
+	_, err := s.eventRepo.UpdateEvent(ctx, master)
+	return err
+}
+
+func (s *Service) DeleteEvent(ctx context.Context, eventDelete *models.EventDelete) (uint, error) {
+	if eventDelete.Scope == "" || eventDelete.Scope == models.ScopeAll {
+		ID, err := s.eventRepo.DeleteEvent(ctx, eventDelete.UserID, eventDelete.ID)
+		if err != nil {
+			return 0, fmt.Errorf("service/DeleteEvent - %w", err)
+		}
+
+		return ID, nil
+	}
+
+	if eventDelete.RecurrenceID == nil {
+		return 0, fmt.Errorf("service/DeleteEvent - %w", ErrRecurrenceIDRequired)
+	}
+
+	master, err := s.eventRepo.GetEvent(ctx, eventDelete.UserID, eventDelete.ID)
+	if err != nil {
+		return 0, fmt.Errorf("service/DeleteEvent - %w", err)
+	}
+	localize(master)
+	master.UserID = eventDelete.UserID
+
+	var ID uint
+	switch {
+	case master.RRule == "" || (eventDelete.Scope == models.ScopeFollowing && !eventDelete.RecurrenceID.After(master.Date)):
+		ID, err = s.eventRepo.DeleteEvent(ctx, eventDelete.UserID, eventDelete.ID)
+	case eventDelete.Scope == models.ScopeThis:
+		master.ExDates = append(master.ExDates, *eventDelete.RecurrenceID)
+		ID, err = s.eventRepo.UpdateEvent(ctx, master)
+	default:
+		var rule *rrule.Rule
+		rule, err = rrule.Parse(master.RRule)
+		if err == nil {
+			err = s.truncateSeries(ctx, master, rule, *eventDelete.RecurrenceID)
+		}
+		ID = master.ID
+	}
 	if err != nil {
 		return 0, fmt.Errorf("service/DeleteEvent - %w", err)
 	}
@@ -52,11 +429,283 @@ func (s *Service) DeleteEvent(ctx context.Context, ID uint) (uint, error) {
 	return ID, nil
 }
 
+// GetEvents returns every occurrence within the window, flagging those that
+// fall outside the user's working hours; callers that page through the events
+// use GetEventsPage instead.
 func (s *Service) GetEvents(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error) {
-	events, err := s.eventRepo.GetEvents(ctx, eventGet)
+	eventGet.Limit, eventGet.Cursor = 0, ""
+
+	page, err := s.eventsPage(ctx, eventGet)
 	if err != nil {
 		return nil, fmt.Errorf("service/GetEvents - %w", err)
 	}
 
-	return events, nil
+	return page.Events, nil
+}
+
+// GetEventsPage returns up to eventGet.Limit occurrences within the window
+// that follow eventGet.Cursor, ordered by date and ID, and the cursor of the
+// next page if there is one.
+func (s *Service) GetEventsPage(ctx context.Context, eventGet *models.EventGet) (*models.EventPage, error) {
+	page, err := s.eventsPage(ctx, eventGet)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetEventsPage - %w", err)
+	}
+
+	return page, nil
+}
+
+func (s *Service) eventsPage(ctx context.Context, eventGet *models.EventGet) (*models.EventPage, error) {
+	after, err := decodeCursor(eventGet.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	eventGet.After = after
+
+	if eventGet.Query != "" {
+		eventGet.TSQuery = tsQuery(eventGet.Query)
+		if eventGet.TSQuery == "" {
+			return nil, ErrEmptySearchQuery
+		}
+	}
+
+	events, err := s.events(ctx, eventGet)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.EventPage{Events: events}
+	if eventGet.Limit > 0 && len(events) > eventGet.Limit {
+		page.Events = events[:eventGet.Limit]
+		page.NextCursor = encodeCursor(page.Events[len(page.Events)-1])
+	}
+
+	if len(page.Events) == 0 {
+		return page, nil
+	}
+
+	availability, err := s.availability(ctx, []int{eventGet.UserID}, eventGet.DateFrom, eventGet.DateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	a := availability[eventGet.UserID]
+	for _, e := range page.Events {
+		e.OutsideWorkingHours = !e.AllDay && a.outsideWorkingHours(e.Date, e.EndDate)
+	}
+
+	return page, nil
+}
+
+// events returns the occurrences within the window in the order of
+// eventGet.Sort that follow eventGet.After.
+func (s *Service) events(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error) {
+	events, err := s.eventRepo.GetEvents(ctx, eventGet)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences, err := expandAll(events, eventGet.DateFrom, eventGet.DateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := eventGet.Sort == models.SortDateDesc
+	if desc {
+		slices.Reverse(occurrences)
+	}
+
+	if eventGet.After == nil {
+		return occurrences, nil
+	}
+
+	result := occurrences[:0]
+	for _, e := range occurrences {
+		if follows(e, eventGet.After, desc) {
+			result = append(result, e)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetArchivedEvents(ctx context.Context, eventGet *models.EventGet) ([]*models.Event, error) {
+	events, err := s.eventRepo.GetArchivedEvents(ctx, eventGet)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetArchivedEvents - %w", err)
+	}
+
+	result, err := expandAll(events, eventGet.DateFrom, eventGet.DateTo)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetArchivedEvents - %w", err)
+	}
+
+	return result, nil
+}
+
+func expandAll(events []*models.Event, from, to time.Time) ([]*models.Event, error) {
+	result := make([]*models.Event, 0, len(events))
+	for _, e := range events {
+		occurrences, err := Expand(e, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, occurrences...)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if !result[i].Date.Equal(result[j].Date) {
+			return result[i].Date.Before(result[j].Date)
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
+
+// Expand returns the occurrences of the event that overlap [from, to) in the
+// event's time zone. A single event is its only occurrence.
+func Expand(e *models.Event, from, to time.Time) ([]*models.Event, error) {
+	localize(e)
+	if e.RRule == "" {
+		if !overlaps(e.Date, e.EndDate, from, to) {
+			return nil, nil
+		}
+		return []*models.Event{e}, nil
+	}
+
+	rule, err := rrule.Parse(e.RRule)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := e.EndDate.Sub(e.Date)
+
+	var occurrences []*models.Event
+	for _, date := range rule.Between(e.Date, from.Add(-duration), to) {
+		if !overlaps(date, date.Add(duration), from, to) || isExcluded(e.ExDates, date) {
+			continue
+		}
+
+		recurrenceID := date
+		occurrence := *e
+		occurrence.Date = date
+		occurrence.EndDate = date.Add(duration)
+		occurrence.RecurrenceID = &recurrenceID
+		occurrence.ExDates = nil
+		occurrences = append(occurrences, &occurrence)
+	}
+
+	return occurrences, nil
+}
+
+// normalizeTimes moves the event into its time zone, fills in a missing end
+// and snaps all-day events to whole days. All-day events keep the calendar
+// date they were sent with; their end is exclusive, so an all-day event ends
+// at the following midnight.
+func normalizeTimes(date, endDate time.Time, allDay bool, loc *time.Location) (time.Time, time.Time) {
+	if allDay {
+		y, m, d := date.Date()
+		date = time.Date(y, m, d, 0, 0, 0, 0, loc)
+
+		if endDate.IsZero() {
+			return date, date.AddDate(0, 0, 1)
+		}
+
+		y, m, d = endDate.Date()
+		end := time.Date(y, m, d, 0, 0, 0, 0, loc)
+		if endDate.Hour() != 0 || endDate.Minute() != 0 || endDate.Second() != 0 {
+			end = end.AddDate(0, 0, 1)
+		}
+		if !end.After(date) {
+			end = date.AddDate(0, 0, 1)
+		}
+
+		return date, end
+	}
+
+	date = date.In(loc)
+	if endDate.IsZero() || endDate.Before(date) {
+		return date, date
+	}
+
+	return date, endDate.In(loc)
+}
+
+// localize converts the stored instants into the event's own time zone, which
+// recurrence expansion relies on to keep the wall-clock time across DST.
+func localize(e *models.Event) {
+	loc := location(e.TimeZone)
+	e.Date = e.Date.In(loc)
+	e.EndDate = e.EndDate.In(loc)
+}
+
+func location(timeZone string) *time.Location {
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// overlaps reports whether an event overlaps the half-open window [from, to);
+// an instant event counts when it starts inside the window.
+func overlaps(start, end, from, to time.Time) bool {
+	return start.Before(to) && (end.After(from) || !start.Before(from))
+}
+
+func sameEvent(a, b *models.Event) bool {
+	if a.Title != b.Title || a.Description != b.Description || a.Location != b.Location || a.URL != b.URL ||
+		!a.Date.Equal(b.Date) || !a.EndDate.Equal(b.EndDate) || a.AllDay != b.AllDay ||
+		a.TimeZone != b.TimeZone || a.RRule != b.RRule ||
+		len(a.Reminders) != len(b.Reminders) || len(a.ExDates) != len(b.ExDates) {
+		return false
+	}
+
+	for i := range a.Reminders {
+		if a.Reminders[i] != b.Reminders[i] {
+			return false
+		}
+	}
+	for i := range a.ExDates {
+		if !a.ExDates[i].Equal(b.ExDates[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isExcluded(exDates []time.Time, date time.Time) bool {
+	for _, ex := range exDates {
+		if ex.Equal(date) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func pastExDates(exDates []time.Time, at time.Time) []time.Time {
+	var result []time.Time
+	for _, ex := range exDates {
+		if ex.Before(at) {
+			result = append(result, ex)
+		}
+	}
+
+	return result
+}
+
+func futureExDates(exDates []time.Time, at time.Time) []time.Time {
+	var result []time.Time
+	for _, ex := range exDates {
+		if !ex.Before(at) {
+			result = append(result, ex)
+		}
+	}
+
+	return result
 }
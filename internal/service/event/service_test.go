@@ -5,6 +5,7 @@ package event
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 
 	eventR "github.com/avraam311/calendar-service/internal/mocks"
 	"github.com/avraam311/calendar-service/internal/models"
+	eventRepository "github.com/avraam311/calendar-service/internal/repository/event"
 )
 
 func TestServiceCreateEvent(t *testing.T) {
@@ -23,22 +25,43 @@ func TestServiceCreateEvent(t *testing.T) {
 
 	ev := &models.EventCreate{
 		UserID: 1,
-		Event:  "Test Event",
+		Title:  "Test Event",
 		Date:   time.Now(),
 	}
 	eventID := uint(1)
 
+	mockRepo.EXPECT().WritableCalendar(gomock.Any(), 1, uint(0)).Return(uint(5), nil)
 	mockRepo.EXPECT().
 		CreateEvent(gomock.Any(), ev).
 		Return(eventID, nil)
 
-	id, err := svc.CreateEvent(context.Background(), ev)
+	id, _, err := svc.CreateEvent(context.Background(), ev)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if id != eventID {
 		t.Fatalf("expected id %v, got %v", eventID, id)
 	}
+	if ev.CalendarID != 5 {
+		t.Fatalf("expected the default calendar, got %v", ev.CalendarID)
+	}
+}
+
+func TestServiceCreateEventReadOnlyCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	mockRepo.EXPECT().WritableCalendar(gomock.Any(), 1, uint(7)).Return(uint(0), eventRepository.ErrForbidden)
+
+	_, _, err := svc.CreateEvent(context.Background(), &models.EventCreate{
+		UserID: 1, CalendarID: 7, Title: "Team offsite", Date: time.Now(),
+	})
+	if !errors.Is(err, eventRepository.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden, got %v", err)
+	}
 }
 
 func TestServiceUpdateEvent(t *testing.T) {
@@ -49,18 +72,21 @@ func TestServiceUpdateEvent(t *testing.T) {
 	svc := New(mockRepo)
 
 	eventID := uint(1)
-	ev := &models.Event{
-		ID:     eventID,
-		UserID: 1,
-		Event:  "Update",
-		Date:   time.Now(),
+	ev := &models.EventUpdate{
+		Event: models.Event{
+			ID:     eventID,
+			UserID: 1,
+			Title:  "Update",
+			Date:   time.Now(),
+		},
 	}
 
+	mockRepo.EXPECT().GetEvent(gomock.Any(), 1, eventID).Return(&models.Event{ID: eventID, UserID: 1}, nil)
 	mockRepo.EXPECT().
-		UpdateEvent(gomock.Any(), ev).
+		UpdateEvent(gomock.Any(), &ev.Event).
 		Return(eventID, nil)
 
-	id, err := svc.UpdateEvent(context.Background(), ev)
+	id, _, err := svc.UpdateEvent(context.Background(), ev)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -79,10 +105,10 @@ func TestServiceDeleteEvent(t *testing.T) {
 	eventID := uint(1)
 
 	mockRepo.EXPECT().
-		DeleteEvent(gomock.Any(), eventID).
+		DeleteEvent(gomock.Any(), 1, eventID).
 		Return(eventID, nil)
 
-	id, err := svc.DeleteEvent(context.Background(), eventID)
+	id, err := svc.DeleteEvent(context.Background(), &models.EventDelete{ID: eventID, UserID: 1})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -99,7 +125,7 @@ func TestServiceGetEvents(t *testing.T) {
 	svc := New(mockRepo)
 
 	mockEvents := []*models.Event{
-		{ID: uint(1), UserID: 1, Event: "Event Week", Date: time.Now()},
+		{ID: uint(1), UserID: 1, Title: "Event Week", Date: time.Now()},
 	}
 
 	date := time.Date(2026, 1, 22, 0, 0, 0, 0, time.UTC)
@@ -123,3 +149,338 @@ func TestServiceGetEvents(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestServiceUpdateOccurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	occurrence := start.AddDate(0, 0, 7)
+	master := &models.Event{ID: 1, UserID: 1, Title: "Standup", Date: start, RRule: "FREQ=WEEKLY"}
+
+	ev := &models.EventUpdate{
+		Event: models.Event{
+			ID:           1,
+			UserID:       1,
+			Title:        "Moved standup",
+			Date:         occurrence.Add(time.Hour),
+			RRule:        "FREQ=WEEKLY",
+			RecurrenceID: &occurrence,
+		},
+		Scope: models.ScopeThis,
+	}
+
+	mockRepo.EXPECT().GetEvent(gomock.Any(), 1, uint(1)).Return(master, nil)
+	expectTx(mockRepo)
+	mockRepo.EXPECT().
+		UpdateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e *models.Event) (uint, error) {
+			if len(e.ExDates) != 1 || !e.ExDates[0].Equal(occurrence) {
+				t.Fatalf("expected occurrence to be excluded, got %v", e.ExDates)
+			}
+			return e.ID, nil
+		})
+	mockRepo.EXPECT().
+		CreateEvent(gomock.Any(), &models.EventCreate{
+			UserID: 1, Title: "Moved standup", Date: occurrence.Add(time.Hour), EndDate: occurrence.Add(time.Hour),
+			TimeZone: "UTC",
+		}).
+		Return(uint(2), nil)
+	mockRepo.EXPECT().CopyAttendees(gomock.Any(), uint(1), uint(2)).Return(nil)
+
+	id, _, err := svc.UpdateEvent(context.Background(), ev)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != 2 {
+		t.Fatalf("expected id %v, got %v", 2, id)
+	}
+}
+
+func TestServiceUpdateOccurrenceFailsInTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	occurrence := start.AddDate(0, 0, 7)
+	master := &models.Event{ID: 1, UserID: 1, Title: "Standup", Date: start, RRule: "FREQ=WEEKLY"}
+
+	ev := &models.EventUpdate{
+		Event: models.Event{
+			ID:           1,
+			UserID:       1,
+			Title:        "Moved standup",
+			Date:         occurrence,
+			RecurrenceID: &occurrence,
+		},
+		Scope: models.ScopeThis,
+	}
+
+	copyErr := errors.New("copy failed")
+	mockRepo.EXPECT().GetEvent(gomock.Any(), 1, uint(1)).Return(master, nil)
+	mockRepo.EXPECT().
+		InTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			err := fn(ctx)
+			if !errors.Is(err, copyErr) {
+				t.Fatalf("expected the transaction to fail with %v, got %v", copyErr, err)
+			}
+			return err
+		})
+	mockRepo.EXPECT().UpdateEvent(gomock.Any(), gomock.Any()).Return(uint(1), nil)
+	mockRepo.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Return(uint(2), nil)
+	mockRepo.EXPECT().CopyAttendees(gomock.Any(), uint(1), uint(2)).Return(copyErr)
+
+	_, _, err := svc.UpdateEvent(context.Background(), ev)
+	if !errors.Is(err, copyErr) {
+		t.Fatalf("expected %v, got %v", copyErr, err)
+	}
+}
+
+func TestServiceDeleteFollowingRequiresRecurrenceID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	_, err := svc.DeleteEvent(context.Background(), &models.EventDelete{ID: 1, Scope: models.ScopeFollowing})
+	if !errors.Is(err, ErrRecurrenceIDRequired) {
+		t.Fatalf("expected ErrRecurrenceIDRequired, got %v", err)
+	}
+}
+
+func TestServiceGetEventsExpandsRecurring(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	start := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	mockEvents := []*models.Event{
+		{
+			ID: 1, UserID: 1, Title: "Daily", Date: start, EndDate: start.Add(time.Hour),
+			RRule: "FREQ=DAILY", ExDates: []time.Time{start.AddDate(0, 0, 2)},
+		},
+		{ID: 2, UserID: 1, Title: "Single", Date: start.AddDate(0, 0, 1).Add(time.Hour)},
+	}
+
+	getData := &models.EventGet{
+		UserID: 1, DateFrom: start, DateTo: start.AddDate(0, 0, 3),
+	}
+
+	mockRepo.EXPECT().
+		GetEvents(gomock.Any(), getData).
+		Return(mockEvents, nil)
+	mockRepo.EXPECT().GetAvailability(gomock.Any(), []int{1}, getData.DateFrom, getData.DateTo).Return(nil, nil)
+
+	events, err := svc.GetEvents(context.Background(), getData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []time.Time{start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 1).Add(time.Hour)}
+	if len(events) != len(expected) {
+		t.Fatalf("expected %d events, got %d", len(expected), len(events))
+	}
+	for i, e := range events {
+		if !e.Date.Equal(expected[i]) {
+			t.Fatalf("event %d: expected date %v, got %v", i, expected[i], e.Date)
+		}
+	}
+	if events[0].RecurrenceID == nil || events[2].RecurrenceID != nil {
+		t.Fatal("expected recurrence_id only on occurrences")
+	}
+}
+
+func TestServiceCreateAllDayEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	ev := &models.EventCreate{
+		UserID: 1,
+		Title:  "Offsite",
+		Date:   time.Date(2026, 3, 2, 13, 30, 0, 0, time.UTC),
+		AllDay: true,
+	}
+
+	mockRepo.EXPECT().WritableCalendar(gomock.Any(), 1, uint(0)).Return(uint(5), nil)
+	mockRepo.EXPECT().
+		CreateEvent(gomock.Any(), &models.EventCreate{
+			UserID:     1,
+			CalendarID: 5,
+			Title:      "Offsite",
+			Date:       time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			EndDate:    time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC),
+			AllDay:     true,
+			TimeZone:   "UTC",
+		}).
+		Return(uint(1), nil)
+
+	if _, _, err := svc.CreateEvent(context.Background(), ev); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestServiceGetEventsIncludesOverlappingOccurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	start := time.Date(2026, 1, 1, 22, 0, 0, 0, time.UTC)
+	mockEvents := []*models.Event{
+		{ID: 1, UserID: 1, Title: "Night shift", Date: start, EndDate: start.Add(10 * time.Hour), RRule: "FREQ=WEEKLY"},
+	}
+
+	from := time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC)
+	getData := &models.EventGet{UserID: 1, DateFrom: from, DateTo: from.Add(24 * time.Hour)}
+
+	mockRepo.EXPECT().GetEvents(gomock.Any(), getData).Return(mockEvents, nil)
+	mockRepo.EXPECT().GetAvailability(gomock.Any(), []int{1}, getData.DateFrom, getData.DateTo).Return(nil, nil)
+
+	events, err := svc.GetEvents(context.Background(), getData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 1 || !events[0].Date.Equal(start.AddDate(0, 0, 7)) {
+		t.Fatalf("expected the occurrence started the day before, got %v", events)
+	}
+}
+
+func TestServiceGetEventsKeepsLocalTimeAcrossDST(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal("can't load location:", err)
+	}
+
+	start := time.Date(2026, 3, 23, 9, 0, 0, 0, berlin).UTC()
+	mockEvents := []*models.Event{
+		{ID: 1, UserID: 1, Title: "Standup", Date: start, EndDate: start, TimeZone: "Europe/Berlin", RRule: "FREQ=WEEKLY"},
+	}
+
+	getData := &models.EventGet{UserID: 1, DateFrom: start, DateTo: time.Date(2026, 4, 6, 0, 0, 0, 0, berlin)}
+
+	mockRepo.EXPECT().GetEvents(gomock.Any(), getData).Return(mockEvents, nil)
+	mockRepo.EXPECT().GetAvailability(gomock.Any(), []int{1}, getData.DateFrom, getData.DateTo).Return(nil, nil)
+
+	events, err := svc.GetEvents(context.Background(), getData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+	for _, e := range events {
+		if e.Date.Hour() != 9 || e.Date.Location().String() != "Europe/Berlin" {
+			t.Fatalf("expected 09:00 Europe/Berlin, got %v", e.Date)
+		}
+	}
+}
+
+func TestServiceImportEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	date := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	events := []*models.EventCreate{
+		{UserID: 1, UID: "new", Title: "New", Date: date, EndDate: date.Add(time.Hour)},
+		{UserID: 1, UID: "same", Title: "Same", Date: date, EndDate: date.Add(time.Hour)},
+		{UserID: 1, UID: "changed", Title: "Changed", Date: date, EndDate: date.Add(time.Hour)},
+	}
+
+	expectTx(mockRepo)
+	mockRepo.EXPECT().WritableCalendar(gomock.Any(), 1, uint(0)).Return(uint(5), nil)
+	mockRepo.EXPECT().GetEventByUID(gomock.Any(), 1, "new").Return(nil, nil)
+	mockRepo.EXPECT().CreateEvent(gomock.Any(), events[0]).Return(uint(10), nil)
+	mockRepo.EXPECT().GetEventByUID(gomock.Any(), 1, "same").Return(&models.Event{
+		ID: 11, UserID: 1, Title: "Same", Date: date, EndDate: date.Add(time.Hour), TimeZone: "UTC",
+		Reminders: []int{}, ExDates: []time.Time{},
+	}, nil)
+	mockRepo.EXPECT().GetEventByUID(gomock.Any(), 1, "changed").Return(&models.Event{
+		ID: 12, UserID: 1, Title: "Old title", Date: date, EndDate: date.Add(time.Hour), TimeZone: "UTC",
+		Metadata: map[string]any{"color": "blue"},
+	}, nil)
+	mockRepo.EXPECT().UpdateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e *models.Event) (uint, error) {
+			if e.ID != 12 || e.Title != "Changed" || e.Metadata["color"] != "blue" {
+				t.Fatalf("unexpected update %+v", e)
+			}
+			return e.ID, nil
+		})
+
+	results, err := svc.ImportEvents(context.Background(), events)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []models.ImportResult{
+		{UID: "new", ID: 10, Status: models.ImportCreated},
+		{UID: "same", ID: 11, Status: models.ImportSkipped},
+		{UID: "changed", ID: 12, Status: models.ImportUpdated},
+	}
+	for i, want := range expected {
+		if *results[i] != want {
+			t.Fatalf("result %d: expected %+v, got %+v", i, want, *results[i])
+		}
+	}
+}
+
+func TestServiceImportEventsFailsAsAWhole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	date := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	events := []*models.EventCreate{
+		{UserID: 1, UID: "first", Title: "First", Date: date, EndDate: date.Add(time.Hour)},
+		{UserID: 1, UID: "second", Title: "Second", Date: date, EndDate: date.Add(time.Hour)},
+	}
+
+	createErr := errors.New("insert failed")
+	expectTx(mockRepo)
+	mockRepo.EXPECT().WritableCalendar(gomock.Any(), 1, uint(0)).Return(uint(5), nil)
+	mockRepo.EXPECT().GetEventByUID(gomock.Any(), 1, "first").Return(nil, nil)
+	mockRepo.EXPECT().CreateEvent(gomock.Any(), events[0]).Return(uint(10), nil)
+	mockRepo.EXPECT().GetEventByUID(gomock.Any(), 1, "second").Return(nil, nil)
+	mockRepo.EXPECT().CreateEvent(gomock.Any(), events[1]).Return(uint(0), createErr)
+
+	results, err := svc.ImportEvents(context.Background(), events)
+	if !errors.Is(err, createErr) {
+		t.Fatalf("expected %v, got %v", createErr, err)
+	}
+	if results != nil {
+		t.Fatalf("expected no results, got %v", results)
+	}
+}
+
+// expectTx lets the mock run a transaction's body inline.
+func expectTx(m *eventR.MockeventRepo) *gomock.Call {
+	return m.EXPECT().
+		InTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+}
@@ -0,0 +1,154 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/period"
+)
+
+const (
+	defaultSlotStep  = 15
+	defaultSlotLimit = 5
+	maxSlotWindow    = 31 * 24 * time.Hour
+)
+
+var (
+	ErrSlotWindowTooLong   = errors.New("search window must not exceed 31 days")
+	ErrInvalidWorkingHours = errors.New("working hours must end after they start")
+)
+
+// FindSlots suggests meeting slots for the participants. Participants are busy
+// during their events, outside their working hours and while out of office.
+// Ranked by earliest start, only slots where everyone is free are returned;
+// ranked by fewest conflicts, slots where some participants are busy follow
+// the free ones.
+func (s *Service) FindSlots(ctx context.Context, query *models.SlotQuery) ([]*models.Slot, error) {
+	if query.DateTo.Sub(query.DateFrom) > maxSlotWindow {
+		return nil, fmt.Errorf("service/FindSlots - %w", ErrSlotWindowTooLong)
+	}
+
+	hours, err := parseWorkingHours(query.WorkingHours)
+	if err != nil {
+		return nil, fmt.Errorf("service/FindSlots - %w", err)
+	}
+
+	if err = s.checkPeers(ctx, query.UserID, query.UserIDs); err != nil {
+		return nil, fmt.Errorf("service/FindSlots - %w", err)
+	}
+
+	freeBusy, err := s.freeBusy(ctx, query.UserIDs, query.DateFrom, query.DateTo)
+	if err != nil {
+		return nil, fmt.Errorf("service/FindSlots - %w", err)
+	}
+
+	availability, err := s.availability(ctx, query.UserIDs, query.DateFrom, query.DateTo)
+	if err != nil {
+		return nil, fmt.Errorf("service/FindSlots - %w", err)
+	}
+
+	step := time.Duration(query.Step) * time.Minute
+	if step == 0 {
+		step = defaultSlotStep * time.Minute
+	}
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultSlotLimit
+	}
+	duration := time.Duration(query.Duration) * time.Minute
+	loc := location(query.TimeZone)
+
+	start := query.DateFrom.Truncate(step)
+	if start.Before(query.DateFrom) {
+		start = start.Add(step)
+	}
+
+	var slots []*models.Slot
+	for ; !start.Add(duration).After(query.DateTo); start = start.Add(step) {
+		end := start.Add(duration)
+		if hours != nil && !hours.contain(start.In(loc), end.In(loc)) {
+			continue
+		}
+
+		slot := &models.Slot{Start: start.UTC(), End: end.UTC(), BusyUserIDs: []int{}}
+		for _, fb := range freeBusy {
+			if isBusy(fb.Busy, start, end) || !availability[fb.UserID].available(start, end) {
+				slot.BusyUserIDs = append(slot.BusyUserIDs, fb.UserID)
+			}
+		}
+
+		if len(slot.BusyUserIDs) > 0 && query.Rank != models.RankFewestConflicts {
+			continue
+		}
+
+		slots = append(slots, slot)
+		if query.Rank != models.RankFewestConflicts && len(slots) == limit {
+			break
+		}
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool { return len(slots[i].BusyUserIDs) < len(slots[j].BusyUserIDs) })
+	if len(slots) > limit {
+		slots = slots[:limit]
+	}
+
+	return slots, nil
+}
+
+// isBusy reports whether any of the sorted busy intervals overlaps [start, end).
+func isBusy(busy []models.BusyInterval, start, end time.Time) bool {
+	i := sort.Search(len(busy), func(i int) bool { return busy[i].End.After(start) })
+	return i < len(busy) && busy[i].Start.Before(end)
+}
+
+type workingHours struct {
+	start, end time.Time
+	days       map[time.Weekday]bool
+}
+
+func parseWorkingHours(wh *models.WorkingHours) (*workingHours, error) {
+	if wh == nil {
+		return nil, nil
+	}
+
+	start, err := time.Parse("15:04", wh.Start)
+	if err != nil {
+		return nil, ErrInvalidWorkingHours
+	}
+	end, err := time.Parse("15:04", wh.End)
+	if err != nil || !end.After(start) {
+		return nil, ErrInvalidWorkingHours
+	}
+
+	hours := &workingHours{start: start, end: end}
+	if len(wh.Days) > 0 {
+		hours.days = make(map[time.Weekday]bool, len(wh.Days))
+		for _, d := range wh.Days {
+			day, err := period.ParseWeekday(d)
+			if err != nil {
+				return nil, ErrInvalidWorkingHours
+			}
+			hours.days[day] = true
+		}
+	}
+
+	return hours, nil
+}
+
+// contain reports whether [start, end), given in the query's time zone, lies
+// within the working hours of a single working day.
+func (h *workingHours) contain(start, end time.Time) bool {
+	if h.days != nil && !h.days[start.Weekday()] {
+		return false
+	}
+
+	y, m, d := start.Date()
+	dayStart := time.Date(y, m, d, h.start.Hour(), h.start.Minute(), 0, 0, start.Location())
+	dayEnd := time.Date(y, m, d, h.end.Hour(), h.end.Minute(), 0, 0, start.Location())
+
+	return !start.Before(dayStart) && !end.After(dayEnd)
+}
@@ -0,0 +1,151 @@
+//go:build unit
+// +build unit
+
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	eventR "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+// slotFixture books user 2 from 9:00 to 10:00 and user 3 from 9:30 to 11:00 on
+// Monday, 2 March 2026.
+func slotFixture(t *testing.T) (*Service, time.Time) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.EXPECT().
+		GetEvents(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, eventGet *models.EventGet) ([]*models.Event, error) {
+			switch eventGet.UserID {
+			case 2:
+				return []*models.Event{
+					{ID: 1, UserID: 2, Date: day.Add(9 * time.Hour), EndDate: day.Add(10 * time.Hour), TimeZone: "UTC"},
+				}, nil
+			case 3:
+				return []*models.Event{
+					{ID: 2, UserID: 3, Date: day.Add(9*time.Hour + 30*time.Minute), EndDate: day.Add(11 * time.Hour),
+						TimeZone: "UTC"},
+				}, nil
+			}
+			return nil, nil
+		}).
+		AnyTimes()
+	mockRepo.EXPECT().GetAvailability(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockRepo.EXPECT().
+		CalendarPeers(gomock.Any(), 1, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, userIDs []int) ([]int, error) { return userIDs, nil }).
+		AnyTimes()
+
+	return New(mockRepo), day
+}
+
+func TestServiceFindSlotsEarliest(t *testing.T) {
+	svc, day := slotFixture(t)
+
+	slots, err := svc.FindSlots(context.Background(), &models.SlotQuery{
+		UserID:       1,
+		UserIDs:      []int{2, 3},
+		Duration:     60,
+		DateFrom:     day,
+		DateTo:       day.AddDate(0, 0, 1),
+		WorkingHours: &models.WorkingHours{Start: "09:00", End: "18:00"},
+		Step:         30,
+		Limit:        2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(slots) != 2 {
+		t.Fatalf("expected 2 slots, got %d", len(slots))
+	}
+	if !slots[0].Start.Equal(day.Add(11*time.Hour)) || !slots[1].Start.Equal(day.Add(11*time.Hour+30*time.Minute)) {
+		t.Fatalf("unexpected slots %v, %v", slots[0].Start, slots[1].Start)
+	}
+}
+
+func TestServiceFindSlotsFewestConflicts(t *testing.T) {
+	svc, day := slotFixture(t)
+
+	slots, err := svc.FindSlots(context.Background(), &models.SlotQuery{
+		UserID:       1,
+		UserIDs:      []int{2, 3},
+		Duration:     60,
+		DateFrom:     day.Add(9 * time.Hour),
+		DateTo:       day.Add(12 * time.Hour),
+		WorkingHours: &models.WorkingHours{Start: "09:00", End: "12:00"},
+		Step:         60,
+		Limit:        3,
+		Rank:         models.RankFewestConflicts,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 11:00 is free for both, 10:00 only for user 2, 9:00 for neither.
+	want := []struct {
+		start time.Time
+		busy  int
+	}{
+		{day.Add(11 * time.Hour), 0},
+		{day.Add(10 * time.Hour), 1},
+		{day.Add(9 * time.Hour), 2},
+	}
+	if len(slots) != len(want) {
+		t.Fatalf("expected %d slots, got %d", len(want), len(slots))
+	}
+	for i, w := range want {
+		if !slots[i].Start.Equal(w.start) || len(slots[i].BusyUserIDs) != w.busy {
+			t.Fatalf("slot %d: expected %v with %d busy, got %v with %v", i, w.start, w.busy, slots[i].Start,
+				slots[i].BusyUserIDs)
+		}
+	}
+}
+
+func TestServiceFindSlotsWorkingDays(t *testing.T) {
+	svc, day := slotFixture(t)
+
+	// 2 March 2026 is a Monday, so the first Tuesday slot is the earliest.
+	slots, err := svc.FindSlots(context.Background(), &models.SlotQuery{
+		UserID:       1,
+		UserIDs:      []int{4},
+		Duration:     30,
+		DateFrom:     day,
+		DateTo:       day.AddDate(0, 0, 7),
+		TimeZone:     "Europe/Moscow",
+		WorkingHours: &models.WorkingHours{Start: "10:00", End: "18:00", Days: []string{"tuesday"}},
+		Limit:        1,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(slots) != 1 || !slots[0].Start.Equal(time.Date(2026, 3, 3, 7, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected slots %v", slots)
+	}
+}
+
+func TestServiceFindSlotsWindowTooLong(t *testing.T) {
+	svc, day := slotFixture(t)
+
+	_, err := svc.FindSlots(context.Background(), &models.SlotQuery{
+		UserIDs:  []int{2},
+		Duration: 30,
+		DateFrom: day,
+		DateTo:   day.AddDate(0, 2, 0),
+	})
+	if !errors.Is(err, ErrSlotWindowTooLong) {
+		t.Fatalf("expected ErrSlotWindowTooLong, got %v", err)
+	}
+}
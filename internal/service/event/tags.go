@@ -0,0 +1,92 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func (s *Service) CreateTag(ctx context.Context, tag *models.Tag) (uint, error) {
+	tag.Name = strings.TrimSpace(tag.Name)
+
+	ID, err := s.eventRepo.CreateTag(ctx, tag)
+	if err != nil {
+		return 0, fmt.Errorf("service/CreateTag - %w", err)
+	}
+
+	return ID, nil
+}
+
+func (s *Service) GetTags(ctx context.Context, userID int) ([]*models.Tag, error) {
+	tags, err := s.eventRepo.GetTags(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetTags - %w", err)
+	}
+
+	return tags, nil
+}
+
+func (s *Service) UpdateTag(ctx context.Context, tag *models.Tag) error {
+	tag.Name = strings.TrimSpace(tag.Name)
+
+	if err := s.eventRepo.UpdateTag(ctx, tag); err != nil {
+		return fmt.Errorf("service/UpdateTag - %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteTag(ctx context.Context, userID int, ID uint) error {
+	if err := s.eventRepo.DeleteTag(ctx, userID, ID); err != nil {
+		return fmt.Errorf("service/DeleteTag - %w", err)
+	}
+
+	return nil
+}
+
+// SetEventTags replaces the user's tags on an event the user can edit.
+func (s *Service) SetEventTags(ctx context.Context, eventTags *models.EventTags) error {
+	if _, err := s.eventRepo.GetEvent(ctx, eventTags.UserID, eventTags.EventID); err != nil {
+		return fmt.Errorf("service/SetEventTags - %w", err)
+	}
+
+	if err := s.eventRepo.SetEventTags(ctx, eventTags); err != nil {
+		return fmt.Errorf("service/SetEventTags - %w", err)
+	}
+
+	return nil
+}
+
+// TagSummary counts the occurrences of the user's events within the window
+// per tag. Every tag of the user is listed, unused ones with a zero count.
+func (s *Service) TagSummary(ctx context.Context, eventGet *models.EventGet) ([]*models.TagCount, error) {
+	tags, err := s.eventRepo.GetTags(ctx, eventGet.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("service/TagSummary - %w", err)
+	}
+
+	events, err := s.events(ctx, eventGet)
+	if err != nil {
+		return nil, fmt.Errorf("service/TagSummary - %w", err)
+	}
+
+	counts := make(map[string]int, len(tags))
+	for _, e := range events {
+		for _, name := range e.Tags {
+			counts[strings.ToLower(name)]++
+		}
+	}
+
+	summary := make([]*models.TagCount, 0, len(tags))
+	for _, t := range tags {
+		summary = append(summary, &models.TagCount{
+			TagID: t.ID,
+			Name:  t.Name,
+			Count: counts[strings.ToLower(t.Name)],
+		})
+	}
+
+	return summary, nil
+}
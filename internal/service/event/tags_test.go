@@ -0,0 +1,99 @@
+//go:build unit
+// +build unit
+
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	eventR "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+	eventRepository "github.com/avraam311/calendar-service/internal/repository/event"
+)
+
+func TestServiceCreateTagTrimsName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	mockRepo.EXPECT().CreateTag(gomock.Any(), &models.Tag{UserID: 1, Name: "Work"}).Return(uint(3), nil)
+
+	ID, err := svc.CreateTag(context.Background(), &models.Tag{UserID: 1, Name: "  Work "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ID != 3 {
+		t.Fatalf("expected ID 3, got %d", ID)
+	}
+}
+
+func TestServiceSetEventTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	eventTags := &models.EventTags{EventID: 7, UserID: 1, TagIDs: []uint{2}}
+	mockRepo.EXPECT().GetEvent(gomock.Any(), 1, uint(7)).Return(&models.Event{ID: 7}, nil)
+	mockRepo.EXPECT().SetEventTags(gomock.Any(), eventTags).Return(nil)
+
+	if err := svc.SetEventTags(context.Background(), eventTags); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestServiceSetEventTagsForbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	mockRepo.EXPECT().GetEvent(gomock.Any(), 2, uint(7)).Return(nil, eventRepository.ErrForbidden)
+
+	err := svc.SetEventTags(context.Background(), &models.EventTags{EventID: 7, UserID: 2, TagIDs: []uint{2}})
+	if !errors.Is(err, eventRepository.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestServiceTagSummary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := eventR.NewMockeventRepo(ctrl)
+	svc := New(mockRepo)
+
+	from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	getData := &models.EventGet{UserID: 1, DateFrom: from, DateTo: from.AddDate(0, 0, 7)}
+	mockRepo.EXPECT().GetTags(gomock.Any(), 1).Return([]*models.Tag{
+		{ID: 1, UserID: 1, Name: "Home"},
+		{ID: 2, UserID: 1, Name: "Work"},
+	}, nil)
+	mockRepo.EXPECT().GetEvents(gomock.Any(), getData).Return([]*models.Event{
+		{ID: 1, UserID: 1, Date: from.Add(9 * time.Hour), EndDate: from.Add(10 * time.Hour), TimeZone: "UTC",
+			RRule: "FREQ=DAILY;COUNT=3", Tags: []string{"work"}},
+	}, nil)
+
+	summary, err := svc.TagSummary(context.Background(), getData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(summary) != 2 {
+		t.Fatalf("expected 2 tags, got %d", len(summary))
+	}
+	if summary[0].Name != "Home" || summary[0].Count != 0 {
+		t.Fatalf("unexpected count for Home: %+v", summary[0])
+	}
+	if summary[1].TagID != 2 || summary[1].Count != 3 {
+		t.Fatalf("unexpected count for Work: %+v", summary[1])
+	}
+}
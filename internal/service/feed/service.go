@@ -0,0 +1,62 @@
+package feed
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+const tokenBytes = 32
+
+//go:generate mockgen -source=service.go -destination=../../mocks/mock_feed_service.go -package=mocks
+type feedRepo interface {
+	SaveToken(ctx context.Context, userID int, token string) error
+	DeleteToken(ctx context.Context, userID int) error
+	GetFeed(ctx context.Context, token string) (*models.Feed, error)
+}
+
+type Service struct {
+	feedRepo feedRepo
+}
+
+func New(r feedRepo) *Service {
+	return &Service{
+		feedRepo: r,
+	}
+}
+
+// CreateToken issues a new secret feed token for the user. A previous token
+// stops working.
+func (s *Service) CreateToken(ctx context.Context, userID int) (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("service/CreateToken - %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	if err := s.feedRepo.SaveToken(ctx, userID, token); err != nil {
+		return "", fmt.Errorf("service/CreateToken - %w", err)
+	}
+
+	return token, nil
+}
+
+func (s *Service) RevokeToken(ctx context.Context, userID int) error {
+	if err := s.feedRepo.DeleteToken(ctx, userID); err != nil {
+		return fmt.Errorf("service/RevokeToken - %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetFeed(ctx context.Context, token string) (*models.Feed, error) {
+	feed, err := s.feedRepo.GetFeed(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetFeed - %w", err)
+	}
+
+	return feed, nil
+}
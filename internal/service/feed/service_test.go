@@ -0,0 +1,37 @@
+//go:build unit
+// +build unit
+
+package feed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	feedR "github.com/avraam311/calendar-service/internal/mocks"
+)
+
+func TestServiceCreateToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := feedR.NewMockfeedRepo(ctrl)
+	svc := New(mockRepo)
+
+	var saved string
+	mockRepo.EXPECT().
+		SaveToken(gomock.Any(), 1, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, token string) error {
+			saved = token
+			return nil
+		})
+
+	token, err := svc.CreateToken(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(token) != 43 || token != saved {
+		t.Fatalf("unexpected token %q (saved %q)", token, saved)
+	}
+}
@@ -0,0 +1,100 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+var (
+	ErrInvalidWorkingHours = errors.New("working hours must end after they start")
+)
+
+//go:generate mockgen -source=service.go -destination=../../mocks/mock_settings_service.go -package=mocks
+type settingsRepo interface {
+	GetSettings(ctx context.Context, userID int) (*models.UserSettings, error)
+	SaveSettings(ctx context.Context, settings *models.UserSettings) error
+	DeleteSettings(ctx context.Context, userID int) error
+	CreateOutOfOffice(ctx context.Context, ooo *models.OutOfOffice) (uint, error)
+	GetOutOfOffice(ctx context.Context, userID int) ([]*models.OutOfOffice, error)
+	DeleteOutOfOffice(ctx context.Context, userID int, ID uint) error
+}
+
+type Service struct {
+	settingsRepo settingsRepo
+}
+
+func New(r settingsRepo) *Service {
+	return &Service{
+		settingsRepo: r,
+	}
+}
+
+// GetSettings returns the user's settings together with their upcoming
+// out-of-office periods.
+func (s *Service) GetSettings(ctx context.Context, userID int) (*models.UserSettings, error) {
+	settings, err := s.settingsRepo.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetSettings - %w", err)
+	}
+
+	settings.OutOfOffice, err = s.settingsRepo.GetOutOfOffice(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetSettings - %w", err)
+	}
+
+	return settings, nil
+}
+
+func (s *Service) SaveSettings(ctx context.Context, settings *models.UserSettings) error {
+	for _, wh := range settings.WorkingHours {
+		start, _ := time.Parse("15:04", wh.Start)
+		end, _ := time.Parse("15:04", wh.End)
+		if !end.After(start) {
+			return fmt.Errorf("service/SaveSettings - %w", ErrInvalidWorkingHours)
+		}
+	}
+
+	if err := s.settingsRepo.SaveSettings(ctx, settings); err != nil {
+		return fmt.Errorf("service/SaveSettings - %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteSettings(ctx context.Context, userID int) error {
+	if err := s.settingsRepo.DeleteSettings(ctx, userID); err != nil {
+		return fmt.Errorf("service/DeleteSettings - %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) CreateOutOfOffice(ctx context.Context, ooo *models.OutOfOffice) (uint, error) {
+	ID, err := s.settingsRepo.CreateOutOfOffice(ctx, ooo)
+	if err != nil {
+		return 0, fmt.Errorf("service/CreateOutOfOffice - %w", err)
+	}
+
+	return ID, nil
+}
+
+func (s *Service) GetOutOfOffice(ctx context.Context, userID int) ([]*models.OutOfOffice, error) {
+	periods, err := s.settingsRepo.GetOutOfOffice(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("service/GetOutOfOffice - %w", err)
+	}
+
+	return periods, nil
+}
+
+func (s *Service) DeleteOutOfOffice(ctx context.Context, userID int, ID uint) error {
+	if err := s.settingsRepo.DeleteOutOfOffice(ctx, userID, ID); err != nil {
+		return fmt.Errorf("service/DeleteOutOfOffice - %w", err)
+	}
+
+	return nil
+}
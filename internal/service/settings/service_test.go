@@ -0,0 +1,74 @@
+//go:build unit
+// +build unit
+
+package settings
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	settingsM "github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func TestServiceGetSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := settingsM.NewMocksettingsRepo(ctrl)
+	svc := New(mockRepo)
+
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	periods := []*models.OutOfOffice{{ID: 3, UserID: 1, Start: start, End: start.AddDate(0, 0, 5)}}
+	mockRepo.EXPECT().GetSettings(gomock.Any(), 1).
+		Return(&models.UserSettings{UserID: 1, TimeZone: "Europe/Moscow"}, nil)
+	mockRepo.EXPECT().GetOutOfOffice(gomock.Any(), 1).Return(periods, nil)
+
+	settings, err := svc.GetSettings(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if settings.TimeZone != "Europe/Moscow" || len(settings.OutOfOffice) != 1 {
+		t.Fatalf("unexpected settings %+v", settings)
+	}
+}
+
+func TestServiceSaveSettingsInvalidWorkingHours(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := settingsM.NewMocksettingsRepo(ctrl)
+	svc := New(mockRepo)
+
+	err := svc.SaveSettings(context.Background(), &models.UserSettings{
+		UserID:       1,
+		TimeZone:     "UTC",
+		WorkingHours: []models.WorkingHours{{Start: "18:00", End: "09:00"}},
+	})
+	if !errors.Is(err, ErrInvalidWorkingHours) {
+		t.Fatalf("expected ErrInvalidWorkingHours, got %v", err)
+	}
+}
+
+func TestServiceSaveSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := settingsM.NewMocksettingsRepo(ctrl)
+	svc := New(mockRepo)
+
+	settings := &models.UserSettings{
+		UserID:       1,
+		TimeZone:     "UTC",
+		WorkingHours: []models.WorkingHours{{Start: "09:00", End: "18:00"}},
+	}
+	mockRepo.EXPECT().SaveSettings(gomock.Any(), settings).Return(nil)
+
+	if err := svc.SaveSettings(context.Background(), settings); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
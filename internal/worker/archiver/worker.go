@@ -0,0 +1,92 @@
+package archiver
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/models"
+	"github.com/avraam311/calendar-service/internal/pkg/rrule"
+)
+
+// farFuture bounds the search for a finished series' remaining occurrences.
+var farFuture = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+//go:generate mockgen -source=worker.go -destination=../../mocks/mock_archiver_worker.go -package=mocks
+type archiveRepo interface {
+	GetRecurringEventsBefore(ctx context.Context, before time.Time) ([]*models.Event, error)
+	ArchiveEvents(ctx context.Context, before time.Time, seriesIDs []uint) (int64, error)
+}
+
+type Worker struct {
+	logger      *zap.Logger
+	archiveRepo archiveRepo
+	interval    time.Duration
+	maxAge      time.Duration
+}
+
+func New(l *zap.Logger, r archiveRepo, interval, maxAge time.Duration) *Worker {
+	return &Worker{
+		logger:      l,
+		archiveRepo: r,
+		interval:    interval,
+		maxAge:      maxAge,
+	}
+}
+
+// Run archives old events every interval until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("archiver stopped")
+			return
+		case now := <-ticker.C:
+			n, err := w.Archive(ctx, now.Add(-w.maxAge))
+			if err != nil {
+				w.logger.Error("failed to archive events", zap.Error(err))
+				continue
+			}
+			if n > 0 {
+				w.logger.Info("events archived", zap.Int64("count", n))
+			}
+		}
+	}
+}
+
+// Archive moves every event that ended before the cutoff into the archive.
+// A recurring series is archived only once its last occurrence has ended;
+// series without COUNT or UNTIL never end and stay in place.
+func (w *Worker) Archive(ctx context.Context, before time.Time) (int64, error) {
+	series, err := w.archiveRepo.GetRecurringEventsBefore(ctx, before)
+	if err != nil {
+		return 0, err
+	}
+
+	var seriesIDs []uint
+	for _, e := range series {
+		if seriesEnded(e, before) {
+			seriesIDs = append(seriesIDs, e.ID)
+		}
+	}
+
+	return w.archiveRepo.ArchiveEvents(ctx, before, seriesIDs)
+}
+
+func seriesEnded(e *models.Event, before time.Time) bool {
+	rule, err := rrule.Parse(e.RRule)
+	if err != nil || (rule.Count == 0 && rule.Until.IsZero()) {
+		return false
+	}
+
+	if loc, err := time.LoadLocation(e.TimeZone); err == nil {
+		e.Date = e.Date.In(loc)
+	}
+
+	duration := e.EndDate.Sub(e.Date)
+	return len(rule.Between(e.Date, before.Add(-duration), farFuture)) == 0
+}
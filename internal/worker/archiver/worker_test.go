@@ -0,0 +1,46 @@
+//go:build unit
+// +build unit
+
+package archiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func TestWorkerArchiveFinishedSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockarchiveRepo(ctrl)
+	logger, _ := zap.NewDevelopment()
+	w := New(logger, mockRepo, time.Hour, 30*24*time.Hour)
+
+	before := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+
+	series := []*models.Event{
+		{ID: 1, Date: start, EndDate: start.Add(time.Hour), TimeZone: "UTC", RRule: "FREQ=WEEKLY;COUNT=4"},
+		{ID: 2, Date: start, EndDate: start.Add(time.Hour), TimeZone: "UTC", RRule: "FREQ=WEEKLY;COUNT=20"},
+		{ID: 3, Date: start, EndDate: start.Add(time.Hour), TimeZone: "UTC", RRule: "FREQ=DAILY;UNTIL=20260110T090000Z"},
+		{ID: 4, Date: start, EndDate: start.Add(time.Hour), TimeZone: "UTC", RRule: "FREQ=DAILY"},
+	}
+
+	mockRepo.EXPECT().GetRecurringEventsBefore(gomock.Any(), before).Return(series, nil)
+	mockRepo.EXPECT().ArchiveEvents(gomock.Any(), before, []uint{1, 3}).Return(int64(5), nil)
+
+	n, err := w.Archive(context.Background(), before)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 5 {
+		t.Fatalf("expected 5 archived events, got %d", n)
+	}
+}
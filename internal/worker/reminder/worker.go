@@ -0,0 +1,113 @@
+package reminder
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/models"
+	eventS "github.com/avraam311/calendar-service/internal/service/event"
+)
+
+// maxReminderOffset matches the largest reminder accepted by validation.
+const maxReminderOffset = 7 * 24 * time.Hour
+
+//go:generate mockgen -source=worker.go -destination=../../mocks/mock_reminder_worker.go -package=mocks
+type reminderRepo interface {
+	GetEventsWithReminders(ctx context.Context, from, to time.Time) ([]*models.Event, error)
+	MarkSent(ctx context.Context, notification *models.Notification) (bool, error)
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, notification *models.Notification) error
+}
+
+type Worker struct {
+	logger       *zap.Logger
+	reminderRepo reminderRepo
+	notifiers    []Notifier
+	interval     time.Duration
+}
+
+func New(l *zap.Logger, r reminderRepo, interval time.Duration, notifiers []Notifier) *Worker {
+	return &Worker{
+		logger:       l,
+		reminderRepo: r,
+		notifiers:    notifiers,
+		interval:     interval,
+	}
+}
+
+// Run polls for due reminders every interval until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	last := time.Now().Add(-w.interval)
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("reminder worker stopped")
+			return
+		case now := <-ticker.C:
+			if err := w.Dispatch(ctx, last, now); err != nil {
+				w.logger.Error("failed to dispatch reminders", zap.Error(err))
+				continue
+			}
+			last = now
+		}
+	}
+}
+
+// Dispatch sends every reminder whose time falls into (from, to].
+func (w *Worker) Dispatch(ctx context.Context, from, to time.Time) error {
+	events, err := w.reminderRepo.GetEventsWithReminders(ctx, from, to.Add(maxReminderOffset))
+	if err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		occurrences, err := eventS.Expand(e, from, to.Add(maxReminderOffset))
+		if err != nil {
+			w.logger.Warn("failed to expand event", zap.Uint("event_id", e.ID), zap.Error(err))
+			continue
+		}
+
+		for _, occurrence := range occurrences {
+			for _, minutes := range occurrence.Reminders {
+				remindAt := occurrence.Date.Add(-time.Duration(minutes) * time.Minute)
+				if !remindAt.After(from) || remindAt.After(to) {
+					continue
+				}
+
+				w.send(ctx, &models.Notification{
+					EventID:       occurrence.ID,
+					UserID:        occurrence.UserID,
+					Event:         occurrence.Title,
+					Date:          occurrence.Date,
+					MinutesBefore: minutes,
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *Worker) send(ctx context.Context, notification *models.Notification) {
+	ok, err := w.reminderRepo.MarkSent(ctx, notification)
+	if err != nil {
+		w.logger.Error("failed to mark reminder as sent", zap.Uint("event_id", notification.EventID), zap.Error(err))
+		return
+	}
+	if !ok {
+		return
+	}
+
+	for _, n := range w.notifiers {
+		if err := n.Notify(ctx, notification); err != nil {
+			w.logger.Error("failed to send reminder", zap.Uint("event_id", notification.EventID), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,72 @@
+//go:build unit
+// +build unit
+
+package reminder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/avraam311/calendar-service/internal/mocks"
+	"github.com/avraam311/calendar-service/internal/models"
+)
+
+func TestWorkerDispatchDueReminders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockreminderRepo(ctrl)
+	mockNotifier := mocks.NewMockNotifier(ctrl)
+	logger, _ := zap.NewDevelopment()
+	w := New(logger, mockRepo, time.Minute, []Notifier{mockNotifier})
+
+	from := time.Date(2026, 1, 5, 8, 49, 0, 0, time.UTC)
+	to := from.Add(time.Minute)
+	standup := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+
+	events := []*models.Event{
+		{ID: 1, UserID: 1, Title: "Standup", Date: standup.AddDate(0, 0, -7), EndDate: standup.AddDate(0, 0, -7),
+			RRule: "FREQ=WEEKLY", Reminders: []int{10, 60}},
+		{ID: 2, UserID: 1, Title: "Lunch", Date: standup.Add(3 * time.Hour), EndDate: standup.Add(4 * time.Hour),
+			Reminders: []int{10}},
+	}
+
+	expected := &models.Notification{EventID: 1, UserID: 1, Event: "Standup", Date: standup, MinutesBefore: 10}
+
+	mockRepo.EXPECT().GetEventsWithReminders(gomock.Any(), from, to.Add(maxReminderOffset)).Return(events, nil)
+	mockRepo.EXPECT().MarkSent(gomock.Any(), expected).Return(true, nil)
+	mockNotifier.EXPECT().Notify(gomock.Any(), expected).Return(nil)
+
+	if err := w.Dispatch(context.Background(), from, to); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWorkerDispatchSkipsAlreadySent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockreminderRepo(ctrl)
+	mockNotifier := mocks.NewMockNotifier(ctrl)
+	logger, _ := zap.NewDevelopment()
+	w := New(logger, mockRepo, time.Minute, []Notifier{mockNotifier})
+
+	from := time.Date(2026, 1, 5, 8, 49, 0, 0, time.UTC)
+	to := from.Add(time.Minute)
+	date := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+
+	events := []*models.Event{
+		{ID: 1, UserID: 1, Title: "Standup", Date: date, EndDate: date, Reminders: []int{10}},
+	}
+
+	mockRepo.EXPECT().GetEventsWithReminders(gomock.Any(), from, to.Add(maxReminderOffset)).Return(events, nil)
+	mockRepo.EXPECT().MarkSent(gomock.Any(), gomock.Any()).Return(false, nil)
+
+	if err := w.Dispatch(context.Background(), from, to); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
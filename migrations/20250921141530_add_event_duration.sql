@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events
+    ADD COLUMN end_date TIMESTAMP,
+    ADD COLUMN all_day BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE events SET end_date = date;
+
+ALTER TABLE events
+    ALTER COLUMN end_date SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS events_user_id_date_idx ON events (user_id, date, end_date);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS events_user_id_date_idx;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS all_day,
+    DROP COLUMN IF EXISTS end_date;
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+SET LOCAL TIME ZONE 'UTC';
+
+ALTER TABLE events
+    ALTER COLUMN date TYPE TIMESTAMPTZ,
+    ALTER COLUMN end_date TYPE TIMESTAMPTZ,
+    ALTER COLUMN exdates DROP DEFAULT,
+    ALTER COLUMN exdates TYPE TIMESTAMPTZ[],
+    ALTER COLUMN exdates SET DEFAULT '{}',
+    ADD COLUMN time_zone TEXT NOT NULL DEFAULT 'UTC';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+SET LOCAL TIME ZONE 'UTC';
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS time_zone,
+    ALTER COLUMN date TYPE TIMESTAMP,
+    ALTER COLUMN end_date TYPE TIMESTAMP,
+    ALTER COLUMN exdates DROP DEFAULT,
+    ALTER COLUMN exdates TYPE TIMESTAMP[],
+    ALTER COLUMN exdates SET DEFAULT '{}';
+
+-- +goose StatementEnd
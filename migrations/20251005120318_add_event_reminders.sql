@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events
+    ADD COLUMN reminders INT[] NOT NULL DEFAULT '{}';
+
+CREATE TABLE IF NOT EXISTS reminder_deliveries (
+    event_id INT NOT NULL,
+    occurrence TIMESTAMPTZ NOT NULL,
+    minutes_before INT NOT NULL,
+    sent_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (event_id, occurrence, minutes_before)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reminder_deliveries;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS reminders;
+
+-- +goose StatementEnd
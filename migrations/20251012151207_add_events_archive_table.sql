@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS events_archive (
+    id INT NOT NULL,
+    user_id INT NOT NULL,
+    event TEXT NOT NULL,
+    date TIMESTAMPTZ NOT NULL,
+    end_date TIMESTAMPTZ NOT NULL,
+    all_day BOOLEAN NOT NULL DEFAULT FALSE,
+    time_zone TEXT NOT NULL DEFAULT 'UTC',
+    reminders INT[] NOT NULL DEFAULT '{}',
+    rrule TEXT NOT NULL DEFAULT '',
+    exdates TIMESTAMPTZ[] NOT NULL DEFAULT '{}',
+    archived_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS events_archive_user_id_date_idx ON events_archive (user_id, date, end_date);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS events_archive;
+
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events
+    ADD COLUMN uid TEXT NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX IF NOT EXISTS events_user_id_uid_idx ON events (user_id, uid) WHERE uid <> '';
+
+ALTER TABLE events_archive
+    ADD COLUMN uid TEXT NOT NULL DEFAULT '';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events_archive
+    DROP COLUMN IF EXISTS uid;
+
+DROP INDEX IF EXISTS events_user_id_uid_idx;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS uid;
+
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS feed_tokens (
+    user_id INT PRIMARY KEY,
+    token TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS event_modifications (
+    user_id INT PRIMARY KEY,
+    modified_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO event_modifications (user_id)
+SELECT DISTINCT user_id FROM events;
+
+CREATE OR REPLACE FUNCTION touch_event_modifications() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        INSERT INTO event_modifications (user_id, modified_at) VALUES (OLD.user_id, clock_timestamp())
+        ON CONFLICT (user_id) DO UPDATE SET modified_at = EXCLUDED.modified_at;
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        INSERT INTO event_modifications (user_id, modified_at) VALUES (NEW.user_id, clock_timestamp())
+        ON CONFLICT (user_id) DO UPDATE SET modified_at = EXCLUDED.modified_at;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER events_touch_modifications
+    AFTER INSERT OR UPDATE OR DELETE ON events
+    FOR EACH ROW EXECUTE FUNCTION touch_event_modifications();
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS events_touch_modifications ON events;
+
+DROP FUNCTION IF EXISTS touch_event_modifications();
+
+DROP TABLE IF EXISTS event_modifications;
+
+DROP TABLE IF EXISTS feed_tokens;
+
+-- +goose StatementEnd
@@ -0,0 +1,74 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE SEQUENCE IF NOT EXISTS event_version_seq;
+
+ALTER TABLE events
+    ADD COLUMN version BIGINT NOT NULL DEFAULT nextval('event_version_seq');
+
+CREATE TABLE IF NOT EXISTS event_changes (
+    version BIGINT PRIMARY KEY,
+    user_id INT NOT NULL,
+    event_id INT NOT NULL,
+    uid TEXT NOT NULL,
+    deleted BOOLEAN NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS event_changes_user_id_version_idx ON event_changes (user_id, version);
+
+INSERT INTO event_changes (version, user_id, event_id, uid, deleted)
+SELECT version, user_id, id, uid, FALSE FROM events;
+
+CREATE OR REPLACE FUNCTION bump_event_version() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version := nextval('event_version_seq');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION log_event_change() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO event_changes (version, user_id, event_id, uid, deleted)
+        VALUES (nextval('event_version_seq'), OLD.user_id, OLD.id, OLD.uid, TRUE);
+        RETURN NULL;
+    END IF;
+
+    IF TG_OP = 'UPDATE' AND (OLD.user_id <> NEW.user_id OR OLD.uid <> NEW.uid) THEN
+        INSERT INTO event_changes (version, user_id, event_id, uid, deleted)
+        VALUES (nextval('event_version_seq'), OLD.user_id, OLD.id, OLD.uid, TRUE);
+    END IF;
+
+    INSERT INTO event_changes (version, user_id, event_id, uid, deleted)
+    VALUES (NEW.version, NEW.user_id, NEW.id, NEW.uid, FALSE);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER events_bump_version
+    BEFORE UPDATE ON events
+    FOR EACH ROW EXECUTE FUNCTION bump_event_version();
+
+CREATE TRIGGER events_log_change
+    AFTER INSERT OR UPDATE OR DELETE ON events
+    FOR EACH ROW EXECUTE FUNCTION log_event_change();
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS events_log_change ON events;
+
+DROP TRIGGER IF EXISTS events_bump_version ON events;
+
+DROP FUNCTION IF EXISTS log_event_change();
+
+DROP FUNCTION IF EXISTS bump_event_version();
+
+DROP TABLE IF EXISTS event_changes;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS version;
+
+DROP SEQUENCE IF EXISTS event_version_seq;
+
+-- +goose StatementEnd
@@ -0,0 +1,122 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS calendars (
+    id SERIAL PRIMARY KEY,
+    owner_id INT NOT NULL,
+    name TEXT NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS calendars_default_idx ON calendars (owner_id) WHERE is_default;
+
+CREATE TABLE IF NOT EXISTS calendar_members (
+    calendar_id INT NOT NULL REFERENCES calendars (id) ON DELETE CASCADE,
+    user_id INT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('owner', 'editor', 'viewer', 'freebusy')),
+    PRIMARY KEY (calendar_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS calendar_members_user_id_idx ON calendar_members (user_id);
+
+-- Every user gets a default calendar that takes over their existing events.
+INSERT INTO calendars (owner_id, name, is_default)
+SELECT user_id, 'Personal', TRUE
+FROM (SELECT user_id FROM events UNION SELECT user_id FROM events_archive) users;
+
+INSERT INTO calendar_members (calendar_id, user_id, role)
+SELECT id, owner_id, 'owner' FROM calendars;
+
+ALTER TABLE events ADD COLUMN calendar_id INT REFERENCES calendars (id);
+
+-- The backfill changes nothing visible, so it must not bump event versions
+-- and force CalDAV clients into a full resync.
+ALTER TABLE events DISABLE TRIGGER USER;
+
+UPDATE events e SET calendar_id = c.id
+FROM calendars c
+WHERE c.owner_id = e.user_id AND c.is_default;
+
+ALTER TABLE events ENABLE TRIGGER USER;
+
+ALTER TABLE events ALTER COLUMN calendar_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS events_calendar_id_date_idx ON events (calendar_id, date);
+
+ALTER TABLE events_archive ADD COLUMN calendar_id INT;
+
+UPDATE events_archive e SET calendar_id = c.id
+FROM calendars c
+WHERE c.owner_id = e.user_id AND c.is_default;
+
+ALTER TABLE events_archive ALTER COLUMN calendar_id SET NOT NULL;
+
+-- Changes in a shared calendar are changes for every member's feed.
+CREATE OR REPLACE FUNCTION touch_event_modifications() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        INSERT INTO event_modifications (user_id, modified_at)
+        SELECT u, clock_timestamp()
+        FROM (SELECT user_id FROM calendar_members WHERE calendar_id = OLD.calendar_id UNION SELECT OLD.user_id) m(u)
+        ON CONFLICT (user_id) DO UPDATE SET modified_at = EXCLUDED.modified_at;
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        INSERT INTO event_modifications (user_id, modified_at)
+        SELECT u, clock_timestamp()
+        FROM (SELECT user_id FROM calendar_members WHERE calendar_id = NEW.calendar_id UNION SELECT NEW.user_id) m(u)
+        ON CONFLICT (user_id) DO UPDATE SET modified_at = EXCLUDED.modified_at;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION touch_member_modifications() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        INSERT INTO event_modifications (user_id, modified_at) VALUES (OLD.user_id, clock_timestamp())
+        ON CONFLICT (user_id) DO UPDATE SET modified_at = EXCLUDED.modified_at;
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        INSERT INTO event_modifications (user_id, modified_at) VALUES (NEW.user_id, clock_timestamp())
+        ON CONFLICT (user_id) DO UPDATE SET modified_at = EXCLUDED.modified_at;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER calendar_members_touch_modifications
+    AFTER INSERT OR UPDATE OR DELETE ON calendar_members
+    FOR EACH ROW EXECUTE FUNCTION touch_member_modifications();
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS calendar_members_touch_modifications ON calendar_members;
+
+DROP FUNCTION IF EXISTS touch_member_modifications();
+
+CREATE OR REPLACE FUNCTION touch_event_modifications() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        INSERT INTO event_modifications (user_id, modified_at) VALUES (OLD.user_id, clock_timestamp())
+        ON CONFLICT (user_id) DO UPDATE SET modified_at = EXCLUDED.modified_at;
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        INSERT INTO event_modifications (user_id, modified_at) VALUES (NEW.user_id, clock_timestamp())
+        ON CONFLICT (user_id) DO UPDATE SET modified_at = EXCLUDED.modified_at;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE events_archive DROP COLUMN IF EXISTS calendar_id;
+
+DROP INDEX IF EXISTS events_calendar_id_date_idx;
+
+ALTER TABLE events DROP COLUMN IF EXISTS calendar_id;
+
+DROP TABLE IF EXISTS calendar_members;
+
+DROP TABLE IF EXISTS calendars;
+
+-- +goose StatementEnd
@@ -0,0 +1,76 @@
+-- +goose Up
+-- +goose StatementBegin
+-- events.id came from a bare SERIAL; attendees reference it, so it needs to
+-- be a key.
+ALTER TABLE events ADD PRIMARY KEY (id);
+
+CREATE TABLE IF NOT EXISTS event_attendees (
+    event_id INT NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    user_id INT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'needs-action'
+        CHECK (status IN ('needs-action', 'accepted', 'declined', 'tentative')),
+    invited_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (event_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS event_attendees_user_id_idx ON event_attendees (user_id);
+
+-- Attendees see the event in their feeds, so its changes are theirs too.
+CREATE OR REPLACE FUNCTION touch_event_modifications() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        INSERT INTO event_modifications (user_id, modified_at)
+        SELECT u, clock_timestamp()
+        FROM (
+            SELECT user_id FROM calendar_members WHERE calendar_id = OLD.calendar_id
+            UNION SELECT user_id FROM event_attendees WHERE event_id = OLD.id
+            UNION SELECT OLD.user_id
+        ) m(u)
+        ON CONFLICT (user_id) DO UPDATE SET modified_at = EXCLUDED.modified_at;
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        INSERT INTO event_modifications (user_id, modified_at)
+        SELECT u, clock_timestamp()
+        FROM (
+            SELECT user_id FROM calendar_members WHERE calendar_id = NEW.calendar_id
+            UNION SELECT user_id FROM event_attendees WHERE event_id = NEW.id
+            UNION SELECT NEW.user_id
+        ) m(u)
+        ON CONFLICT (user_id) DO UPDATE SET modified_at = EXCLUDED.modified_at;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER event_attendees_touch_modifications
+    AFTER INSERT OR UPDATE OR DELETE ON event_attendees
+    FOR EACH ROW EXECUTE FUNCTION touch_member_modifications();
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS event_attendees_touch_modifications ON event_attendees;
+
+CREATE OR REPLACE FUNCTION touch_event_modifications() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        INSERT INTO event_modifications (user_id, modified_at)
+        SELECT u, clock_timestamp()
+        FROM (SELECT user_id FROM calendar_members WHERE calendar_id = OLD.calendar_id UNION SELECT OLD.user_id) m(u)
+        ON CONFLICT (user_id) DO UPDATE SET modified_at = EXCLUDED.modified_at;
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        INSERT INTO event_modifications (user_id, modified_at)
+        SELECT u, clock_timestamp()
+        FROM (SELECT user_id FROM calendar_members WHERE calendar_id = NEW.calendar_id UNION SELECT NEW.user_id) m(u)
+        ON CONFLICT (user_id) DO UPDATE SET modified_at = EXCLUDED.modified_at;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS event_attendees;
+
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_pkey;
+
+-- +goose StatementEnd
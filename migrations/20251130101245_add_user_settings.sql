@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_settings (
+    user_id INT PRIMARY KEY,
+    time_zone TEXT NOT NULL DEFAULT 'UTC',
+    working_hours JSONB NOT NULL DEFAULT '[]',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS out_of_office (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    start_at TIMESTAMPTZ NOT NULL,
+    end_at TIMESTAMPTZ NOT NULL CHECK (end_at > start_at),
+    reason TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS out_of_office_user_id_end_at_idx ON out_of_office (user_id, end_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS out_of_office;
+
+DROP TABLE IF EXISTS user_settings;
+
+-- +goose StatementEnd
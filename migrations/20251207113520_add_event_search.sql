@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The 'simple' configuration does not stem, so titles in any language are
+-- indexed word for word; prefix queries make up for the missing stemming.
+ALTER TABLE events
+    ADD COLUMN search TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', event)) STORED;
+
+CREATE INDEX IF NOT EXISTS events_search_idx ON events USING GIN (search);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS events_search_idx;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS search;
+
+-- +goose StatementEnd
@@ -0,0 +1,66 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP INDEX IF EXISTS events_search_idx;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS search;
+
+-- Existing event text becomes the title.
+ALTER TABLE events
+    RENAME COLUMN event TO title;
+
+ALTER TABLE events
+    ADD COLUMN description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN location TEXT NOT NULL DEFAULT '',
+    ADD COLUMN url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}';
+
+-- Title matches rank above location, and location above description.
+ALTER TABLE events
+    ADD COLUMN search TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', title), 'A') ||
+        setweight(to_tsvector('simple', location), 'B') ||
+        setweight(to_tsvector('simple', description), 'C')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS events_search_idx ON events USING GIN (search);
+
+ALTER TABLE events_archive
+    RENAME COLUMN event TO title;
+
+ALTER TABLE events_archive
+    ADD COLUMN description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN location TEXT NOT NULL DEFAULT '',
+    ADD COLUMN url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events_archive
+    DROP COLUMN IF EXISTS metadata,
+    DROP COLUMN IF EXISTS url,
+    DROP COLUMN IF EXISTS location,
+    DROP COLUMN IF EXISTS description;
+
+ALTER TABLE events_archive
+    RENAME COLUMN title TO event;
+
+DROP INDEX IF EXISTS events_search_idx;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS search,
+    DROP COLUMN IF EXISTS metadata,
+    DROP COLUMN IF EXISTS url,
+    DROP COLUMN IF EXISTS location,
+    DROP COLUMN IF EXISTS description;
+
+ALTER TABLE events
+    RENAME COLUMN title TO event;
+
+ALTER TABLE events
+    ADD COLUMN search TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', event)) STORED;
+
+CREATE INDEX IF NOT EXISTS events_search_idx ON events USING GIN (search);
+
+-- +goose StatementEnd
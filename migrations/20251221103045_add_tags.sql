@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS tags (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL,
+    name TEXT NOT NULL,
+    color TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Tag names are compared case-insensitively, so "Oncall" and "oncall" are
+-- the same tag.
+CREATE UNIQUE INDEX IF NOT EXISTS tags_user_id_name_idx ON tags (user_id, lower(name));
+
+-- events.id is a key since the attendees migration, which this one relies on.
+CREATE TABLE IF NOT EXISTS event_tags (
+    event_id INT NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    tag_id INT NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (event_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS event_tags_tag_id_idx ON event_tags (tag_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_tags;
+
+DROP TABLE IF EXISTS tags;
+
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Attendees of archived events. events_archive has no key, so the rows are
+-- tied to it by event_id only; the archiver moves both in one statement.
+CREATE TABLE IF NOT EXISTS event_attendees_archive (
+    event_id INT NOT NULL,
+    user_id INT NOT NULL,
+    status TEXT NOT NULL,
+    invited_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (event_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS event_attendees_archive_user_id_idx ON event_attendees_archive (user_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_attendees_archive;
+
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Tags of archived events; like event_attendees_archive, tied to
+-- events_archive by event_id only.
+CREATE TABLE IF NOT EXISTS event_tags_archive (
+    event_id INT NOT NULL,
+    tag_id INT NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (event_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS event_tags_archive_tag_id_idx ON event_tags_archive (tag_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_tags_archive;
+
+-- +goose StatementEnd